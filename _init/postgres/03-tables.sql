@@ -48,6 +48,18 @@ CREATE TABLE demo.transaction_log (
     response_time_ms INTEGER
 );
 
+-- Account status changes (for audit purposes)
+CREATE TABLE demo.account_status_log (
+    status_log_id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES demo.accounts(account_id),
+    action VARCHAR(20) NOT NULL, -- OPEN, BLOCK, UNBLOCK, CLOSE
+    status_before VARCHAR(20), -- NULL when the account was opened
+    status_after VARCHAR(20) NOT NULL,
+    reason TEXT,
+    reference_number VARCHAR(50),
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
 -- Create indexes for better query performance
 CREATE INDEX idx_customers_number ON demo.customers(customer_number);
 CREATE INDEX idx_accounts_number ON demo.accounts(account_number);
@@ -55,4 +67,12 @@ CREATE INDEX idx_accounts_customer_id ON demo.accounts(customer_id);
 CREATE INDEX idx_accounts_status ON demo.accounts(account_status);
 CREATE INDEX idx_transaction_log_account_id ON demo.transaction_log(account_id);
 CREATE INDEX idx_transaction_log_time ON demo.transaction_log(transaction_time);
-CREATE INDEX idx_transaction_log_type ON demo.transaction_log(transaction_type);
\ No newline at end of file
+CREATE INDEX idx_transaction_log_type ON demo.transaction_log(transaction_type);
+CREATE INDEX idx_account_status_log_account_id ON demo.account_status_log(account_id, changed_at);
+
+-- Backs the keyset pagination of the transaction history
+CREATE INDEX idx_transaction_log_account_time ON demo.transaction_log(account_id, transaction_time DESC, transaction_id DESC);
+
+-- Debits and credits are idempotent on their reference number
+CREATE UNIQUE INDEX idx_transaction_log_reference ON demo.transaction_log(reference_number, transaction_type)
+    WHERE transaction_type IN ('DEBIT', 'CREDIT');
\ No newline at end of file
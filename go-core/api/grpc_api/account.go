@@ -0,0 +1,228 @@
+package grpc_api
+
+import (
+	"context"
+	"fmt"
+
+	pb "go-core/api/grpc_api/pb"
+	"go-core/service/account_service"
+	"go-core/service/inquiry_service"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (api *Api) OpenAccount(ctx context.Context, request *pb.OpenAccountRequest) (*pb.OpenAccountResponse, error) {
+	const op = "grpc_api.Api.OpenAccount"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.OpenAccountResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.account.OpenAccount(ctx, &account_service.OpenAccountParams{
+		CustomerNumber:  request.CustomerNumber,
+		AccountNumber:   request.AccountNumber,
+		AccountType:     request.AccountType,
+		Currency:        request.Currency,
+		ReferenceNumber: request.ReferenceNumber,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Account = toManagedAccountInfo(&result.Account)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+func (api *Api) BlockAccount(ctx context.Context, request *pb.BlockAccountRequest) (*pb.BlockAccountResponse, error) {
+	const op = "grpc_api.Api.BlockAccount"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.BlockAccountResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.account.BlockAccount(ctx, &account_service.BlockAccountParams{
+		AccountNumber:   request.AccountNumber,
+		Reason:          request.Reason,
+		ReferenceNumber: request.ReferenceNumber,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Account = toManagedAccountInfo(&result.Account)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+func (api *Api) UnblockAccount(ctx context.Context, request *pb.UnblockAccountRequest) (*pb.UnblockAccountResponse, error) {
+	const op = "grpc_api.Api.UnblockAccount"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.UnblockAccountResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.account.UnblockAccount(ctx, &account_service.UnblockAccountParams{
+		AccountNumber:   request.AccountNumber,
+		Reason:          request.Reason,
+		ReferenceNumber: request.ReferenceNumber,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Account = toManagedAccountInfo(&result.Account)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+func (api *Api) CloseAccount(ctx context.Context, request *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	const op = "grpc_api.Api.CloseAccount"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.CloseAccountResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.account.CloseAccount(ctx, &account_service.CloseAccountParams{
+		AccountNumber:   request.AccountNumber,
+		Reason:          request.Reason,
+		ReferenceNumber: request.ReferenceNumber,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Account = toManagedAccountInfo(&result.Account)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+// toManagedAccountInfo maps an account of the account service to protobuf,
+// its fields are the same as those of the inquiry account
+func toManagedAccountInfo(account *account_service.Account) *pb.AccountInfo {
+	return toAccountInfo((*inquiry_service.Account)(account))
+}
@@ -2,14 +2,18 @@ package grpc_api
 
 import (
 	pb "go-core/api/grpc_api/pb"
+	"go-core/service/account_service"
 	"go-core/service/inquiry_service"
+	"go-core/service/transaction_service"
 
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type service struct {
-	inquiry *inquiry_service.Service
+	inquiry     *inquiry_service.Service
+	transaction *transaction_service.Service
+	account     *account_service.Service
 }
 
 type Api struct {
@@ -25,9 +29,13 @@ func NewApi(
 	logger *logrus.Logger,
 	tracer trace.Tracer,
 	inquiryService *inquiry_service.Service,
+	transactionService *transaction_service.Service,
+	accountService *account_service.Service,
 ) *Api {
 	service := &service{
-		inquiry: inquiryService,
+		inquiry:     inquiryService,
+		transaction: transactionService,
+		account:     accountService,
 	}
 
 	return &Api{
@@ -0,0 +1,74 @@
+package grpc_api
+
+import (
+	"context"
+	"fmt"
+
+	pb "go-core/api/grpc_api/pb"
+	"go-core/service/transaction_service"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (api *Api) GetTransactionHistory(ctx context.Context, request *pb.GetTransactionHistoryRequest) (*pb.GetTransactionHistoryResponse, error) {
+	const op = "grpc_api.Api.GetTransactionHistory"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.GetTransactionHistoryResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.transaction.GetTransactionHistory(ctx, &transaction_service.GetTransactionHistoryParams{
+		AccountNumber:   request.AccountNumber,
+		FromTime:        request.FromTime,
+		ToTime:          request.ToTime,
+		TransactionType: request.TransactionType,
+		PageSize:        request.PageSize,
+		PageToken:       request.PageToken,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Transactions = make([]*pb.TransactionInfo, 0, len(result.Transactions))
+	for i := range result.Transactions {
+		response.Transactions = append(response.Transactions, toTransactionInfo(&result.Transactions[i]))
+	}
+	response.NextPageToken = result.NextPageToken
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.Int("output.transactions", len(response.Transactions)),
+		attribute.String("output.next_page_token", response.NextPageToken),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
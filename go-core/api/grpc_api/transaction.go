@@ -0,0 +1,188 @@
+package grpc_api
+
+import (
+	"context"
+	"fmt"
+
+	pb "go-core/api/grpc_api/pb"
+	"go-core/service/transaction_service"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (api *Api) Debit(ctx context.Context, request *pb.DebitRequest) (*pb.DebitResponse, error) {
+	const op = "grpc_api.Api.Debit"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.DebitResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.transaction.Debit(ctx, &transaction_service.DebitParams{
+		AccountNumber:   request.AccountNumber,
+		Amount:          request.Amount,
+		ReferenceNumber: request.ReferenceNumber,
+		Description:     request.Description,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Transaction = toTransactionInfo(&result.Transaction)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+func (api *Api) Credit(ctx context.Context, request *pb.CreditRequest) (*pb.CreditResponse, error) {
+	const op = "grpc_api.Api.Credit"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.CreditResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.transaction.Credit(ctx, &transaction_service.CreditParams{
+		AccountNumber:   request.AccountNumber,
+		Amount:          request.Amount,
+		ReferenceNumber: request.ReferenceNumber,
+		Description:     request.Description,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Transaction = toTransactionInfo(&result.Transaction)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+func (api *Api) Transfer(ctx context.Context, request *pb.TransferRequest) (*pb.TransferResponse, error) {
+	const op = "grpc_api.Api.Transfer"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.TransferResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.transaction.Transfer(ctx, &transaction_service.TransferParams{
+		FromAccountNumber: request.FromAccountNumber,
+		ToAccountNumber:   request.ToAccountNumber,
+		Amount:            request.Amount,
+		ReferenceNumber:   request.ReferenceNumber,
+		Description:       request.Description,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
+	}
+
+	// Map domain models to protobuf response
+	response.Debit = toTransactionInfo(&result.Debit)
+	response.Credit = toTransactionInfo(&result.Credit)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+// toTransactionInfo maps a transaction domain model to protobuf
+func toTransactionInfo(transaction *transaction_service.Transaction) *pb.TransactionInfo {
+	return &pb.TransactionInfo{
+		TransactionId:   transaction.TransactionID,
+		AccountNumber:   transaction.AccountNumber,
+		TransactionType: transaction.TransactionType,
+		Amount:          transaction.Amount,
+		BalanceBefore:   transaction.BalanceBefore,
+		BalanceAfter:    transaction.BalanceAfter,
+		TransactionTime: transaction.TransactionTime,
+		ReferenceNumber: transaction.ReferenceNumber,
+		Description:     transaction.Description,
+	}
+}
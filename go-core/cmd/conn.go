@@ -55,3 +55,37 @@ func createPostgresPoolWithRetry(postgresConfig config.Postgres) (*pgxpool.Pool,
 
 	return nil, fmt.Errorf("failed to connect to postgres after %d attempts", maxRetries)
 }
+
+// createPostgresReplicaPools creates a pool per replica connection string. The
+// pools connect lazily, so an unavailable replica does not stop the service;
+// the store health checks keep reads away from it.
+func createPostgresReplicaPools(postgresConfig config.Postgres) ([]*pgxpool.Pool, error) {
+	var pools []*pgxpool.Pool
+
+	for _, connectionString := range postgresConfig.Replicas.ConnectionStrings {
+		if connectionString == "" {
+			continue
+		}
+
+		pgConfig, err := pgxpool.ParseConfig(connectionString)
+		if err != nil {
+			err = fmt.Errorf("failed to parse postgres replica config: %w", err)
+
+			return nil, err
+		}
+
+		pgConfig.MaxConns = int32(postgresConfig.Pool.MaxConns)
+		pgConfig.MinConns = int32(postgresConfig.Pool.MinConns)
+
+		pool, err := pgxpool.NewWithConfig(context.Background(), pgConfig)
+		if err != nil {
+			err := fmt.Errorf("failed to create postgres replica pool: %w", err)
+
+			return nil, err
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
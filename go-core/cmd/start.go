@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"syscall"
 
 	"go-core/api/grpc_api"
+	"go-core/service/account_service"
 	"go-core/service/inquiry_service"
+	"go-core/service/transaction_service"
+	"go-core/store/cache_store"
 	"go-core/store/postgres_store"
 	"go-core/util/config"
 	"go-core/util/tracing"
@@ -82,24 +86,75 @@ func start() {
 		os.Exit(1)
 	}
 
+	// --- Create postgres replica pools ---
+	replicaPools, err := createPostgresReplicaPools(config.Store.Postgres)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"[op]":  op,
+			"scope": "Create postgres replica pools",
+			"err":   err.Error(),
+		}).Error()
+
+		os.Exit(1)
+	}
+
 	// --- Init store layers ---
-	postgresStore := postgres_store.NewStore(logger, tracer, postgresPool)
+	postgresStore := postgres_store.NewStore(logger, tracer, postgresPool, postgres_store.Options{
+		RetryPolicy: postgres_store.RetryPolicy{
+			MaxAttempts: config.Store.Postgres.TxRetry.MaxAttempts,
+			BaseDelay:   config.Store.Postgres.TxRetry.BaseDelay,
+			MaxDelay:    config.Store.Postgres.TxRetry.MaxDelay,
+			Jitter:      config.Store.Postgres.TxRetry.Jitter,
+		},
+		Replicas:                   replicaPools,
+		ReplicaHealthCheckInterval: config.Store.Postgres.Replicas.HealthCheckInterval,
+		ReplicaMaxLag:              config.Store.Postgres.Replicas.MaxLag,
+	})
+	if config.Store.Cache.Enabled {
+		postgresStore = cache_store.NewStore(logger, tracer, postgresStore, cache_store.Options{
+			MaxEntries:    config.Store.Cache.MaxEntries,
+			TTL:           config.Store.Cache.TTL,
+			StatsInterval: config.Store.Cache.StatsInterval,
+			FillTimeout:   config.Store.Cache.FillTimeout,
+		})
+	}
 
 	// --- Init service layers ---
-	inquiryService := inquiry_service.NewService(logger, tracer,  postgresStore)
+	inquiryService, err := inquiry_service.NewService(logger, tracer, postgresStore, inquiry_service.AuditOptions{
+		Mode:          config.Audit.Inquiry.Mode,
+		BatchSize:     config.Audit.Inquiry.BatchSize,
+		FlushInterval: config.Audit.Inquiry.FlushInterval,
+		QueueSize:     config.Audit.Inquiry.QueueSize,
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"[op]":  op,
+			"scope": "Init inquiry service",
+			"err":   err.Error(),
+		}).Error()
+
+		os.Exit(1)
+	}
+	transactionService := transaction_service.NewService(logger, tracer, postgresStore)
+	accountService := account_service.NewService(logger, tracer, postgresStore)
 
 	// --- Init api layers ---
-	grpcApi := grpc_api.NewApi(logger, tracer, inquiryService)
+	grpcApi := grpc_api.NewApi(logger, tracer, inquiryService, transactionService, accountService)
 
 	// --- Run servers ---
-	runGrpcServer(config.App.Port.Grpc, grpcApi)
+	grpcServer := runGrpcServer(config.App.Port.Grpc, grpcApi)
 
-	// --- Wait for signal ---
+	// --- Wait for signal, SIGTERM is sent by docker stop ---
 	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
 
 	// --- Block until signal is received ---
 	<-ch
 
+	// --- Stop serving, write pending inquiry audits, then close the database pools ---
+	grpcServer.GracefulStop()
+	inquiryService.Close()
+	postgresStore.Close()
+
 	logger.Info("end of program...")
 }
@@ -0,0 +1,78 @@
+package account_service
+
+import (
+	"context"
+	"fmt"
+
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type CloseAccountParams struct {
+	AccountNumber   string
+	Reason          string
+	ReferenceNumber string
+}
+
+type CloseAccountResult struct {
+	Account Account
+}
+
+// CloseAccount moves an ACTIVE account with a zero balance to CLOSED and sets
+// its closed date. A closed account cannot be reopened.
+func (service *Service) CloseAccount(ctx context.Context, params *CloseAccountParams) (*CloseAccountResult, error) {
+	const op = "account_service.Service.CloseAccount"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &CloseAccountResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	account, err := service.changeStatus(ctx, &statusChange{
+		action:          ActionClose,
+		accountNumber:   params.AccountNumber,
+		reason:          params.Reason,
+		referenceNumber: params.ReferenceNumber,
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Close account",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	result.Account = *account
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package account_service
+
+import (
+	"fmt"
+
+	"go-core/store/postgres_store/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Helper functions for data formatting
+
+// Column sizes of demo.accounts and demo.account_status_log
+const (
+	maxAccountNumberLength   = 20
+	maxReferenceNumberLength = 50
+)
+
+// formatBalance converts pgtype.Numeric to formatted string
+func formatBalance(balance pgtype.Numeric) string {
+	if !balance.Valid {
+		return ""
+	}
+	balanceFloat, _ := balance.Float64Value()
+	return fmt.Sprintf("%.2f", balanceFloat.Float64)
+}
+
+// formatDate converts pgtype.Date to ISO date string (YYYY-MM-DD)
+func formatDate(date pgtype.Date) string {
+	if !date.Valid {
+		return ""
+	}
+	return date.Time.Format("2006-01-02")
+}
+
+// formatTimestamp converts pgtype.Timestamp to ISO datetime string
+func formatTimestamp(timestamp pgtype.Timestamp) string {
+	if !timestamp.Valid {
+		return ""
+	}
+	return timestamp.Time.Format("2006-01-02T15:04:05Z07:00")
+}
+
+// toText converts a string to pgtype.Text, empty strings are NULL
+func toText(text string) pgtype.Text {
+	return pgtype.Text{String: text, Valid: text != ""}
+}
+
+// isZero reports whether a numeric is exactly zero
+func isZero(numeric pgtype.Numeric) bool {
+	return numeric.Valid && !numeric.NaN && numeric.Int != nil && numeric.Int.Sign() == 0
+}
+
+// toAccount maps an account row to the account domain model
+func toAccount(row sqlc.GetAccountByAccountNumberRow) Account {
+	return Account{
+		AccountID:     row.AccountID,
+		AccountNumber: row.AccountNumber,
+		CustomerID:    row.CustomerID,
+		AccountType:   row.AccountType,
+		AccountStatus: row.AccountStatus,
+		Balance:       formatBalance(row.Balance),
+		Currency:      row.Currency,
+		OpenedDate:    formatDate(row.OpenedDate),
+		ClosedDate:    formatDate(row.ClosedDate),
+		CreatedAt:     formatTimestamp(row.CreatedAt),
+		UpdatedAt:     formatTimestamp(row.UpdatedAt),
+	}
+}
+
+// validateReferenceNumber checks the optional reference number of a status change
+func validateReferenceNumber(referenceNumber string) error {
+	if len(referenceNumber) > maxReferenceNumberLength {
+		return fmt.Errorf("reference_number must be at most %d characters", maxReferenceNumberLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package account_service
+
+// Account statuses of demo.accounts
+const (
+	AccountStatusActive  = "ACTIVE"
+	AccountStatusBlocked = "BLOCKED"
+	AccountStatusClosed  = "CLOSED"
+)
+
+// Actions written to demo.account_status_log
+const (
+	ActionOpen    = "OPEN"
+	ActionBlock   = "BLOCK"
+	ActionUnblock = "UNBLOCK"
+	ActionClose   = "CLOSE"
+)
+
+// Account types offered by OpenAccount
+var accountTypes = map[string]bool{
+	"WADIAH":     true,
+	"MUDHARABAH": true,
+	"QARD":       true,
+}
+
+// Account represents the domain model for account entity
+type Account struct {
+	AccountID     int64
+	AccountNumber string
+	CustomerID    int64
+	AccountType   string
+	AccountStatus string
+	Balance       string
+	Currency      string
+	OpenedDate    string
+	ClosedDate    string
+	CreatedAt     string
+	UpdatedAt     string
+}
@@ -0,0 +1,162 @@
+package account_service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultCurrency is used when OpenAccount gets no currency
+const defaultCurrency = "IDR"
+
+type OpenAccountParams struct {
+	CustomerNumber  string
+	AccountNumber   string
+	AccountType     string
+	Currency        string
+	ReferenceNumber string
+}
+
+type OpenAccountResult struct {
+	Account Account
+}
+
+// OpenAccount creates an ACTIVE account with a zero balance for an existing
+// customer and logs the opening in the account status log
+func (service *Service) OpenAccount(ctx context.Context, params *OpenAccountParams) (*OpenAccountResult, error) {
+	const op = "account_service.Service.OpenAccount"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &OpenAccountResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	accountType := strings.ToUpper(params.AccountType)
+	currency := strings.ToUpper(params.Currency)
+	if currency == "" {
+		currency = defaultCurrency
+	}
+
+	if err := validateOpenAccount(params, accountType, currency); err != nil {
+		appErr := apperrors.Wrap(apperrors.ErrorCodeValidation, err, err.Error())
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Validate params",
+			"err":   appErr.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(appErr)
+		span.SetStatus(codes.Error, appErr.Error())
+
+		return nil, appErr
+	}
+
+	_, err := service.store.postgres.WithTx(ctx, &postgres_store.WithTxArgs{
+		Invalidates: []string{params.AccountNumber},
+		Fn: func(q *sqlc.Queries) error {
+			customerID, err := q.GetCustomerIDByCustomerNumber(ctx, params.CustomerNumber)
+			if err != nil {
+				if apperrors.IsCode(err, apperrors.ErrorCodeNotFound) {
+					return apperrors.Newf(apperrors.ErrorCodeNotFound, "customer %s not found", params.CustomerNumber)
+				}
+				return err
+			}
+
+			accountID, err := q.CreateAccount(ctx, sqlc.CreateAccountParams{
+				AccountNumber: params.AccountNumber,
+				CustomerID:    customerID,
+				AccountType:   accountType,
+				Currency:      currency,
+			})
+			if err != nil {
+				if apperrors.IsCode(err, apperrors.ErrorCodeConflict) {
+					return apperrors.Newf(apperrors.ErrorCodeConflict, "account %s already exists", params.AccountNumber)
+				}
+				return err
+			}
+
+			err = q.CreateAccountStatusLog(ctx, sqlc.CreateAccountStatusLogParams{
+				AccountID:       accountID,
+				Action:          ActionOpen,
+				StatusAfter:     AccountStatusActive,
+				ReferenceNumber: toText(params.ReferenceNumber),
+			})
+			if err != nil {
+				return err
+			}
+
+			row, err := q.GetAccountByAccountNumber(ctx, params.AccountNumber)
+			if err != nil {
+				return err
+			}
+			result.Account = toAccount(row)
+
+			return nil
+		},
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Open account",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
+
+// validateOpenAccount checks the params of OpenAccount with the normalized
+// account type and currency
+func validateOpenAccount(params *OpenAccountParams, accountType string, currency string) error {
+	switch {
+	case params.CustomerNumber == "":
+		return fmt.Errorf("customer_number is required")
+	case params.AccountNumber == "":
+		return fmt.Errorf("account_number is required")
+	case len(params.AccountNumber) > maxAccountNumberLength:
+		return fmt.Errorf("account_number must be at most %d characters", maxAccountNumberLength)
+	case !accountTypes[accountType]:
+		return fmt.Errorf("account_type must be one of WADIAH, MUDHARABAH or QARD")
+	case len(currency) != 3:
+		return fmt.Errorf("currency must be a 3-letter code")
+	}
+
+	return validateReferenceNumber(params.ReferenceNumber)
+}
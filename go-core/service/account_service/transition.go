@@ -0,0 +1,123 @@
+package account_service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// transition is an allowed status change of an existing account
+type transition struct {
+	from []string
+	to   string
+}
+
+// transitions is the account status state machine. Opening creates an ACTIVE
+// account, a blocked account must be unblocked before it can be closed, and
+// CLOSED is final.
+var transitions = map[string]transition{
+	ActionBlock:   {from: []string{AccountStatusActive}, to: AccountStatusBlocked},
+	ActionUnblock: {from: []string{AccountStatusBlocked}, to: AccountStatusActive},
+	ActionClose:   {from: []string{AccountStatusActive}, to: AccountStatusClosed},
+}
+
+// statusChange is a request to apply an action to an account
+type statusChange struct {
+	action          string
+	accountNumber   string
+	reason          string
+	referenceNumber string
+}
+
+// changeStatus applies a transition in one serializable transaction: it locks
+// the account, checks the transition, updates the status and writes the
+// account status log. The account is returned as it is after the change.
+func (service *Service) changeStatus(ctx context.Context, change *statusChange) (*Account, error) {
+	if change.accountNumber == "" {
+		return nil, apperrors.New(apperrors.ErrorCodeValidation, "account_number is required")
+	}
+	if err := validateReferenceNumber(change.referenceNumber); err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrorCodeValidation, err, err.Error())
+	}
+
+	var account Account
+
+	_, err := service.store.postgres.WithTx(ctx, &postgres_store.WithTxArgs{
+		Invalidates: []string{change.accountNumber},
+		Fn: func(q *sqlc.Queries) error {
+			rows, err := q.LockAccountsByAccountNumbers(ctx, []string{change.accountNumber})
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				return apperrors.Newf(apperrors.ErrorCodeNotFound, "account %s not found", change.accountNumber)
+			}
+			locked := rows[0]
+
+			statusAfter, err := checkTransition(change.action, change.accountNumber, locked.AccountStatus, locked.Balance)
+			if err != nil {
+				return err
+			}
+
+			err = q.UpdateAccountStatus(ctx, sqlc.UpdateAccountStatusParams{
+				AccountStatus: statusAfter,
+				AccountID:     locked.AccountID,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = q.CreateAccountStatusLog(ctx, sqlc.CreateAccountStatusLogParams{
+				AccountID:       locked.AccountID,
+				Action:          change.action,
+				StatusBefore:    toText(locked.AccountStatus),
+				StatusAfter:     statusAfter,
+				Reason:          toText(change.reason),
+				ReferenceNumber: toText(change.referenceNumber),
+			})
+			if err != nil {
+				return err
+			}
+
+			row, err := q.GetAccountByAccountNumber(ctx, change.accountNumber)
+			if err != nil {
+				return err
+			}
+			account = toAccount(row)
+
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// checkTransition returns the status an account in status moves to when
+// action is applied, or a conflict when the state machine does not allow it.
+// Only accounts without balance can be closed.
+func checkTransition(action string, accountNumber string, status string, balance pgtype.Numeric) (string, error) {
+	transition, ok := transitions[action]
+	if !ok {
+		return "", apperrors.Newf(apperrors.ErrorCodeInternal, "unknown account action %s", action)
+	}
+
+	if !slices.Contains(transition.from, status) {
+		return "", apperrors.Newf(apperrors.ErrorCodeConflict, "cannot %s account %s in status %s",
+			strings.ToLower(action), accountNumber, status)
+	}
+	if action == ActionClose && !isZero(balance) {
+		return "", apperrors.Newf(apperrors.ErrorCodeConflict, "cannot close account %s with balance %s",
+			accountNumber, formatBalance(balance))
+	}
+
+	return transition.to, nil
+}
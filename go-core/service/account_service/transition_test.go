@@ -0,0 +1,124 @@
+package account_service
+
+import (
+	"math/big"
+	"testing"
+
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func numeric(value int64, exp int32) pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(value), Exp: exp, Valid: true}
+}
+
+func TestCheckTransition(t *testing.T) {
+	zero := numeric(0, 0)
+
+	tests := []struct {
+		action string
+		status string
+		want   string // Status after the transition, empty when rejected
+	}{
+		{ActionBlock, AccountStatusActive, AccountStatusBlocked},
+		{ActionBlock, AccountStatusBlocked, ""},
+		{ActionBlock, AccountStatusClosed, ""},
+
+		{ActionUnblock, AccountStatusActive, ""},
+		{ActionUnblock, AccountStatusBlocked, AccountStatusActive},
+		{ActionUnblock, AccountStatusClosed, ""},
+
+		{ActionClose, AccountStatusActive, AccountStatusClosed},
+		{ActionClose, AccountStatusBlocked, ""},
+		{ActionClose, AccountStatusClosed, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.action+" "+test.status, func(t *testing.T) {
+			got, err := checkTransition(test.action, "1001000000001", test.status, zero)
+
+			if test.want == "" {
+				if !apperrors.IsCode(err, apperrors.ErrorCodeConflict) {
+					t.Fatalf("checkTransition() error = %v, want code %s", err, apperrors.ErrorCodeConflict)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("checkTransition() error = %v", err)
+			}
+			if got != test.want {
+				t.Errorf("checkTransition() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCheckTransitionClose(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance pgtype.Numeric
+		allowed bool
+	}{
+		{"zero", numeric(0, 0), true},
+		{"zero with scale", numeric(0, -2), true},
+		{"positive", numeric(1050, -2), false},
+		{"negative", numeric(-1, -2), false},
+		{"null", pgtype.Numeric{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := checkTransition(ActionClose, "1001000000001", AccountStatusActive, test.balance)
+
+			if !test.allowed {
+				if !apperrors.IsCode(err, apperrors.ErrorCodeConflict) {
+					t.Fatalf("checkTransition() error = %v, want code %s", err, apperrors.ErrorCodeConflict)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("checkTransition() error = %v", err)
+			}
+			if got != AccountStatusClosed {
+				t.Errorf("checkTransition() = %s, want %s", got, AccountStatusClosed)
+			}
+		})
+	}
+}
+
+func TestCheckTransitionUnknownAction(t *testing.T) {
+	_, err := checkTransition(ActionOpen, "1001000000001", AccountStatusActive, numeric(0, 0))
+
+	if !apperrors.IsCode(err, apperrors.ErrorCodeInternal) {
+		t.Errorf("checkTransition() error = %v, want code %s", err, apperrors.ErrorCodeInternal)
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	tests := []struct {
+		name    string
+		numeric pgtype.Numeric
+		want    bool
+	}{
+		{"zero", numeric(0, 0), true},
+		{"zero not normalised", numeric(0, -2), true},
+		{"zero positive exponent", numeric(0, 3), true},
+		{"one cent", numeric(1, -2), false},
+		{"minus one cent", numeric(-1, -2), false},
+		{"hundred", numeric(1, 2), false},
+		{"null", pgtype.Numeric{}, false},
+		{"nan", pgtype.Numeric{NaN: true, Valid: true}, false},
+		{"no digits", pgtype.Numeric{Valid: true}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isZero(test.numeric); got != test.want {
+				t.Errorf("isZero(%+v) = %t, want %t", test.numeric, got, test.want)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package inquiry_service
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
 	"go-core/util/logging"
 
 	"github.com/sirupsen/logrus"
@@ -12,7 +15,8 @@ import (
 )
 
 type GetAccountByAccountNumberParams struct {
-	AccountNumber string
+	AccountNumber   string
+	ReferenceNumber string // Recorded by the inquiry audit, the trace ID is used if empty
 }
 
 type GetAccountByAccountNumberResult struct {
@@ -44,9 +48,21 @@ func (service *Service) GetAccountByAccountNumber(ctx context.Context, params *G
 	})
 	logger.Info()
 
+	start := time.Now()
+
 	// Call store layer to get account with customer details
-	row, err := service.store.postgres.GetAccountByAccountNumber(ctx, params.AccountNumber)
+	var row sqlc.GetAccountByAccountNumberRow
+	var err error
+	if service.auditMode == AuditModeSync {
+		row, err = service.getAccountWithAudit(ctx, params, start)
+	} else {
+		row, err = service.store.postgres.GetAccountByAccountNumber(ctx, params.AccountNumber)
+	}
 	if err != nil {
+		if apperrors.IsCode(err, apperrors.ErrorCodeNotFound) {
+			err = apperrors.Wrapf(apperrors.ErrorCodeNotFound, err, "account %s not found", params.AccountNumber)
+		}
+
 		logger.WithFields(logrus.Fields{
 			"scope": "Get account by account number",
 			"err":   err.Error(),
@@ -59,30 +75,17 @@ func (service *Service) GetAccountByAccountNumber(ctx context.Context, params *G
 		return nil, err
 	}
 
-	// Map sqlc row to domain models
-	result.Account = Account{
-		AccountID:     row.AccountID,
-		AccountNumber: row.AccountNumber,
-		CustomerID:    row.CustomerID,
-		AccountType:   row.AccountType,
-		AccountStatus: row.AccountStatus,
-		Balance:       formatBalance(row.Balance),
-		Currency:      row.Currency,
-		OpenedDate:    formatDate(row.OpenedDate),
-		ClosedDate:    formatDate(row.ClosedDate),
-		CreatedAt:     formatTimestamp(row.CreatedAt),
-		UpdatedAt:     formatTimestamp(row.UpdatedAt),
+	if service.auditor != nil && !service.auditor.enqueue(&inquiryAudit{
+		accountID:       row.AccountID,
+		referenceNumber: auditReference(ctx, params.ReferenceNumber),
+		responseTime:    time.Since(start),
+	}) {
+		span.SetAttributes(attribute.Bool("audit.dropped", true))
 	}
 
-	result.Customer = Customer{
-		CustomerNumber: row.CustomerNumber,
-		FullName:       row.FullName,
-		IDNumber:       row.IDNumber,
-		PhoneNumber:    formatText(row.PhoneNumber),
-		Email:          formatText(row.Email),
-		Address:        formatText(row.Address),
-		DateOfBirth:    formatDate(row.DateOfBirth),
-	}
+	// Map sqlc row to domain models
+	result.Account = toAccount(row)
+	result.Customer = toCustomer(row)
 
 	// Set span attributes and status
 	span.SetAttributes(
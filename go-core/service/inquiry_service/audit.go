@@ -0,0 +1,239 @@
+package inquiry_service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Inquiry audit modes
+const (
+	AuditModeOff   = "off"   // Inquiries are not audited
+	AuditModeSync  = "sync"  // The audit record is written in the inquiry transaction
+	AuditModeAsync = "async" // Audit records are queued and written in batches
+)
+
+// Defaults of the asynchronous audit mode
+const (
+	defaultAuditBatchSize     = 100
+	defaultAuditFlushInterval = 100 * time.Millisecond
+	defaultAuditQueueSize     = 10000
+)
+
+// maxReferenceNumberLength is the size of demo.transaction_log.reference_number
+const maxReferenceNumberLength = 50
+
+// AuditOptions configures the auditing of account inquiries
+type AuditOptions struct {
+	Mode          string        // "off" (default), "sync" or "async"
+	BatchSize     int           // Async: records written per batch
+	FlushInterval time.Duration // Async: maximum time a record waits in the queue
+	QueueSize     int           // Async: queued records, further records are dropped
+}
+
+// inquiryAudit is one audit record of an account inquiry
+type inquiryAudit struct {
+	accountID       int64
+	referenceNumber string
+	responseTime    time.Duration
+}
+
+func (audit *inquiryAudit) params() sqlc.LogBalanceInquiryParams {
+	return sqlc.LogBalanceInquiryParams{
+		AccountID:       audit.accountID,
+		ReferenceNumber: pgtype.Text{String: audit.referenceNumber, Valid: audit.referenceNumber != ""},
+		ResponseTimeMs:  pgtype.Int4{Int32: int32(audit.responseTime.Milliseconds()), Valid: true},
+	}
+}
+
+// auditReference is the reference recorded for an inquiry: the caller's
+// reference number, or the trace ID without one
+func auditReference(ctx context.Context, referenceNumber string) string {
+	if referenceNumber == "" {
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			referenceNumber = sc.TraceID().String()
+		}
+	}
+
+	if len(referenceNumber) > maxReferenceNumberLength {
+		referenceNumber = referenceNumber[:maxReferenceNumberLength]
+	}
+
+	return referenceNumber
+}
+
+// getAccountWithAudit reads the account and writes its audit record in the
+// same transaction. The response time covers the read.
+func (service *Service) getAccountWithAudit(ctx context.Context, params *GetAccountByAccountNumberParams, start time.Time) (sqlc.GetAccountByAccountNumberRow, error) {
+	var row sqlc.GetAccountByAccountNumberRow
+
+	_, err := service.store.postgres.WithTxOptions(ctx, &postgres_store.WithTxOptionsArgs{
+		Opts: postgres_store.ReadCommittedTxOptions(),
+		Fn: func(q *sqlc.Queries) error {
+			var err error
+			row, err = q.GetAccountByAccountNumber(ctx, params.AccountNumber)
+			if err != nil {
+				return err
+			}
+
+			audit := &inquiryAudit{
+				accountID:       row.AccountID,
+				referenceNumber: auditReference(ctx, params.ReferenceNumber),
+				responseTime:    time.Since(start),
+			}
+
+			return q.LogBalanceInquiry(ctx, audit.params())
+		},
+	})
+
+	return row, err
+}
+
+// auditor writes queued inquiry audits in batches, one database round trip
+// per batch. Records are dropped rather than slowing inquiries down when the
+// queue is full.
+type auditor struct {
+	logger *logrus.Logger
+	store  postgres_store.IStore
+
+	batchSize     int
+	flushInterval time.Duration
+
+	// mutex guards records against sends after close
+	mutex   sync.RWMutex
+	records chan *inquiryAudit
+	closed  bool
+	dropped atomic.Int64
+
+	done chan struct{}
+}
+
+func newAuditor(logger *logrus.Logger, store postgres_store.IStore, options *AuditOptions) *auditor {
+	auditor := &auditor{
+		logger: logger,
+		store:  store,
+
+		batchSize:     options.BatchSize,
+		flushInterval: options.FlushInterval,
+
+		done: make(chan struct{}),
+	}
+
+	if auditor.batchSize <= 0 {
+		auditor.batchSize = defaultAuditBatchSize
+	}
+	if auditor.flushInterval <= 0 {
+		auditor.flushInterval = defaultAuditFlushInterval
+	}
+
+	queueSize := options.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultAuditQueueSize
+	}
+	auditor.records = make(chan *inquiryAudit, queueSize)
+
+	go auditor.run()
+
+	return auditor
+}
+
+// enqueue queues an audit record, reporting whether there was room for it
+func (auditor *auditor) enqueue(record *inquiryAudit) bool {
+	auditor.mutex.RLock()
+	defer auditor.mutex.RUnlock()
+
+	if auditor.closed {
+		return false
+	}
+
+	select {
+	case auditor.records <- record:
+		return true
+	default:
+		auditor.dropped.Add(1)
+		return false
+	}
+}
+
+// run writes batches when they are full or when the flush interval elapses
+func (auditor *auditor) run() {
+	defer close(auditor.done)
+
+	ticker := time.NewTicker(auditor.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]sqlc.LogBalanceInquiriesParams, 0, auditor.batchSize)
+	for {
+		select {
+		case record, ok := <-auditor.records:
+			if !ok {
+				auditor.flush(batch)
+				return
+			}
+
+			batch = append(batch, sqlc.LogBalanceInquiriesParams(record.params()))
+			if len(batch) >= auditor.batchSize {
+				auditor.flush(batch)
+				batch = batch[:0]
+			}
+
+		case <-ticker.C:
+			auditor.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (auditor *auditor) flush(batch []sqlc.LogBalanceInquiriesParams) {
+	const op = "inquiry_service.auditor.flush"
+
+	if dropped := auditor.dropped.Swap(0); dropped > 0 {
+		auditor.logger.WithFields(logrus.Fields{
+			"[op]":    op,
+			"dropped": dropped,
+		}).Warn("Audit queue full, inquiry audits dropped")
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+
+	// The batch outlives the request contexts it was queued from
+	failed := 0
+	var lastErr error
+	auditor.store.LogBalanceInquiries(context.Background(), batch).Exec(func(_ int, err error) {
+		if err != nil {
+			failed++
+			lastErr = err
+		}
+	})
+
+	if failed > 0 {
+		auditor.logger.WithFields(logrus.Fields{
+			"[op]":   op,
+			"failed": fmt.Sprintf("%d/%d", failed, len(batch)),
+			"err":    lastErr.Error(),
+		}).Error("Failed to write inquiry audits")
+	}
+}
+
+// close writes the queued records and stops the auditor
+func (auditor *auditor) close() {
+	auditor.mutex.Lock()
+	if !auditor.closed {
+		auditor.closed = true
+		close(auditor.records)
+	}
+	auditor.mutex.Unlock()
+
+	<-auditor.done
+}
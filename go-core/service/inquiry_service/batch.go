@@ -0,0 +1,136 @@
+package inquiry_service
+
+import (
+	"context"
+	"fmt"
+
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// maxBatchAccounts bounds the account numbers of one batch inquiry
+const maxBatchAccounts = 100
+
+type BatchGetAccountsParams struct {
+	AccountNumbers []string
+}
+
+type BatchGetAccountsResult struct {
+	Results []AccountResult // One per requested account number, in request order
+}
+
+// AccountResult is the outcome of one account of a batch inquiry. Account
+// and Customer are only set when Found.
+type AccountResult struct {
+	AccountNumber string
+	Found         bool
+	Account       Account
+	Customer      Customer
+}
+
+// BatchGetAccounts looks up several accounts with one query. Missing accounts
+// are reported per account instead of failing the batch.
+func (service *Service) BatchGetAccounts(ctx context.Context, params *BatchGetAccountsParams) (*BatchGetAccountsResult, error) {
+	const op = "inquiry_service.Service.BatchGetAccounts"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &BatchGetAccountsResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	// Validate input
+	var appErr *apperrors.AppError
+	switch {
+	case len(params.AccountNumbers) == 0:
+		appErr = apperrors.New(apperrors.ErrorCodeValidation, "account_numbers is required")
+	case len(params.AccountNumbers) > maxBatchAccounts:
+		appErr = apperrors.Newf(apperrors.ErrorCodeValidation, "account_numbers must have at most %d entries", maxBatchAccounts)
+	}
+	if appErr != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Validate params",
+			"err":   appErr.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(appErr)
+		span.SetStatus(codes.Error, appErr.Error())
+
+		return nil, appErr
+	}
+
+	// Query every account number once
+	unique := make([]string, 0, len(params.AccountNumbers))
+	seen := make(map[string]bool, len(params.AccountNumbers))
+	for _, accountNumber := range params.AccountNumbers {
+		if !seen[accountNumber] {
+			seen[accountNumber] = true
+			unique = append(unique, accountNumber)
+		}
+	}
+
+	// Call store layer to get the accounts with customer details
+	rows, err := service.store.postgres.GetAccountsByAccountNumbers(ctx, unique)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Get accounts by account numbers",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	found := make(map[string]sqlc.GetAccountByAccountNumberRow, len(rows))
+	for _, row := range rows {
+		found[row.AccountNumber] = sqlc.GetAccountByAccountNumberRow(row)
+	}
+
+	// Map sqlc rows to domain models in request order
+	result.Results = make([]AccountResult, 0, len(params.AccountNumbers))
+	for _, accountNumber := range params.AccountNumbers {
+		accountResult := AccountResult{
+			AccountNumber: accountNumber,
+		}
+		if row, ok := found[accountNumber]; ok {
+			accountResult.Found = true
+			accountResult.Account = toAccount(row)
+			accountResult.Customer = toCustomer(row)
+		}
+
+		result.Results = append(result.Results, accountResult)
+	}
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.Int("output.found", len(rows)),
+		attribute.Int("output.not_found", len(unique)-len(rows)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
@@ -3,6 +3,8 @@ package inquiry_service
 import (
 	"fmt"
 
+	"go-core/store/postgres_store/sqlc"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -37,3 +39,33 @@ func formatTimestamp(timestamp pgtype.Timestamp) string {
 func formatText(text pgtype.Text) string {
 	return text.String
 }
+
+// toAccount maps an account row to the account domain model
+func toAccount(row sqlc.GetAccountByAccountNumberRow) Account {
+	return Account{
+		AccountID:     row.AccountID,
+		AccountNumber: row.AccountNumber,
+		CustomerID:    row.CustomerID,
+		AccountType:   row.AccountType,
+		AccountStatus: row.AccountStatus,
+		Balance:       formatBalance(row.Balance),
+		Currency:      row.Currency,
+		OpenedDate:    formatDate(row.OpenedDate),
+		ClosedDate:    formatDate(row.ClosedDate),
+		CreatedAt:     formatTimestamp(row.CreatedAt),
+		UpdatedAt:     formatTimestamp(row.UpdatedAt),
+	}
+}
+
+// toCustomer maps the customer columns of an account row to the customer domain model
+func toCustomer(row sqlc.GetAccountByAccountNumberRow) Customer {
+	return Customer{
+		CustomerNumber: row.CustomerNumber,
+		FullName:       row.FullName,
+		IDNumber:       row.IDNumber,
+		PhoneNumber:    formatText(row.PhoneNumber),
+		Email:          formatText(row.Email),
+		Address:        formatText(row.Address),
+		DateOfBirth:    formatDate(row.DateOfBirth),
+	}
+}
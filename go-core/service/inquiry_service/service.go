@@ -1,6 +1,8 @@
 package inquiry_service
 
 import (
+	"fmt"
+
 	"go-core/store/postgres_store"
 
 	"github.com/sirupsen/logrus"
@@ -16,13 +18,17 @@ type Service struct {
 	tracer trace.Tracer
 
 	store *store
+
+	auditMode string
+	auditor   *auditor // Only in async audit mode
 }
 
 func NewService(
 	logger *logrus.Logger,
 	tracer trace.Tracer,
 	postgresStore postgres_store.IStore,
-) *Service {
+	auditOptions AuditOptions,
+) (*Service, error) {
 	store := &store{
 		postgres: postgresStore,
 	}
@@ -32,7 +38,26 @@ func NewService(
 		tracer: tracer,
 
 		store: store,
+
+		auditMode: auditOptions.Mode,
+	}
+
+	switch service.auditMode {
+	case "":
+		service.auditMode = AuditModeOff
+	case AuditModeOff, AuditModeSync:
+	case AuditModeAsync:
+		service.auditor = newAuditor(logger, postgresStore, &auditOptions)
+	default:
+		return nil, fmt.Errorf("invalid inquiry audit mode: %q", auditOptions.Mode)
 	}
 
-	return service
+	return service, nil
+}
+
+// Close writes the pending inquiry audits
+func (service *Service) Close() {
+	if service.auditor != nil {
+		service.auditor.close()
+	}
 }
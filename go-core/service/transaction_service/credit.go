@@ -0,0 +1,96 @@
+package transaction_service
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type CreditParams struct {
+	AccountNumber   string
+	Amount          string
+	ReferenceNumber string
+	Description     string
+}
+
+type CreditResult struct {
+	Transaction Transaction
+}
+
+func (service *Service) Credit(ctx context.Context, params *CreditParams) (*CreditResult, error) {
+	const op = "transaction_service.Service.Credit"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &CreditResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	amount, err := parseAmount(params.Amount)
+	if err != nil {
+		appErr := apperrors.Wrap(apperrors.ErrorCodeValidation, err, err.Error())
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Parse amount",
+			"err":   appErr.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(appErr)
+		span.SetStatus(codes.Error, appErr.Error())
+
+		return nil, appErr
+	}
+
+	description := params.Description
+	if description == "" {
+		description = "Credit via API"
+	}
+
+	transactions, err := service.post(ctx, params.ReferenceNumber, description, []entry{
+		{accountNumber: params.AccountNumber, transactionType: TransactionTypeCredit, amount: amount},
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Post credit",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	result.Transaction = transactions[0]
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
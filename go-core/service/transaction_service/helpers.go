@@ -0,0 +1,117 @@
+package transaction_service
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Helper functions for amounts and data formatting
+
+var (
+	// maxCents bounds amounts to what DECIMAL(18, 2) can hold
+	maxCents = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	bigTen = big.NewInt(10)
+)
+
+// parseAmount parses a positive decimal amount with at most 2 fraction
+// digits. Exponent notation is rejected.
+func parseAmount(amount string) (pgtype.Numeric, error) {
+	var numeric pgtype.Numeric
+	if err := numeric.Scan(strings.TrimSpace(amount)); err != nil || !numeric.Valid {
+		return pgtype.Numeric{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	if numeric.NaN || numeric.InfinityModifier != pgtype.Finite {
+		return pgtype.Numeric{}, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	value, exact := toCents(numeric)
+	if !exact {
+		return pgtype.Numeric{}, fmt.Errorf("amount %q has more than 2 fraction digits", amount)
+	}
+	if value.Sign() <= 0 {
+		return pgtype.Numeric{}, fmt.Errorf("amount must be greater than 0")
+	}
+	if value.Cmp(maxCents) >= 0 {
+		return pgtype.Numeric{}, fmt.Errorf("amount %q is too large", amount)
+	}
+
+	return pgtype.Numeric{Int: value, Exp: -2, Valid: true}, nil
+}
+
+// toCents converts a numeric to an integer number of cents, reporting
+// whether no fraction digits were dropped
+func toCents(numeric pgtype.Numeric) (*big.Int, bool) {
+	value := new(big.Int).Set(numeric.Int)
+
+	exp := numeric.Exp + 2
+	if exp >= 0 {
+		return value.Mul(value, new(big.Int).Exp(bigTen, big.NewInt(int64(exp)), nil)), true
+	}
+
+	divisor := new(big.Int).Exp(bigTen, big.NewInt(int64(-exp)), nil)
+	remainder := new(big.Int)
+	value.QuoRem(value, divisor, remainder)
+
+	return value, remainder.Sign() == 0
+}
+
+// negate returns -numeric
+func negate(numeric pgtype.Numeric) pgtype.Numeric {
+	return pgtype.Numeric{
+		Int:   new(big.Int).Neg(numeric.Int),
+		Exp:   numeric.Exp,
+		Valid: numeric.Valid,
+	}
+}
+
+// amountsEqual reports whether two numerics hold the same amount
+func amountsEqual(a, b pgtype.Numeric) bool {
+	if !a.Valid || !b.Valid {
+		return a.Valid == b.Valid
+	}
+
+	centsA, _ := toCents(a)
+	centsB, _ := toCents(b)
+
+	return centsA.Cmp(centsB) == 0
+}
+
+// formatAmount converts pgtype.Numeric to a string with 2 fraction digits
+func formatAmount(numeric pgtype.Numeric) string {
+	if !numeric.Valid || numeric.NaN {
+		return ""
+	}
+
+	value, _ := toCents(numeric)
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+		value.Neg(value)
+	}
+
+	digits := fmt.Sprintf("%03s", value.String())
+
+	return sign + digits[:len(digits)-2] + "." + digits[len(digits)-2:]
+}
+
+// formatTimestamp converts pgtype.Timestamp to ISO datetime string
+func formatTimestamp(timestamp pgtype.Timestamp) string {
+	if !timestamp.Valid {
+		return ""
+	}
+	return timestamp.Time.Format("2006-01-02T15:04:05Z07:00")
+}
+
+// formatText converts pgtype.Text to string
+func formatText(text pgtype.Text) string {
+	return text.String
+}
+
+// toText converts a string to pgtype.Text, empty strings are NULL
+func toText(text string) pgtype.Text {
+	return pgtype.Text{String: text, Valid: text != ""}
+}
@@ -0,0 +1,128 @@
+package transaction_service
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func numeric(value int64, exp int32) pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(value), Exp: exp, Valid: true}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		want    string // Formatted amount, empty when rejected
+		wantErr bool
+	}{
+		{name: "integer", amount: "100", want: "100.00"},
+		{name: "cents", amount: "12.34", want: "12.34"},
+		{name: "one fraction digit", amount: "0.5", want: "0.50"},
+		{name: "one cent", amount: "0.01", want: "0.01"},
+		{name: "spaces", amount: " 7.25 ", want: "7.25"},
+		{name: "trailing zeros", amount: "1.2300", want: "1.23"},
+		{name: "largest", amount: "9999999999999999.99", want: "9999999999999999.99"},
+
+		{name: "zero", amount: "0", wantErr: true},
+		{name: "zero cents", amount: "0.00", wantErr: true},
+		{name: "negative", amount: "-5.00", wantErr: true},
+		{name: "three fraction digits", amount: "1.005", wantErr: true},
+		{name: "too large", amount: "10000000000000000", wantErr: true},
+		{name: "exponent", amount: "1.5e2", wantErr: true},
+		{name: "negative exponent", amount: "125e-2", wantErr: true},
+		{name: "empty", amount: "", wantErr: true},
+		{name: "text", amount: "ten", wantErr: true},
+		{name: "NaN", amount: "NaN", wantErr: true},
+		{name: "infinity", amount: "Infinity", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseAmount(test.amount)
+
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("parseAmount(%q) = %s, want error", test.amount, formatAmount(got))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseAmount(%q) error = %v", test.amount, err)
+			}
+			if got.Exp != -2 {
+				t.Errorf("parseAmount(%q) exponent = %d, want -2", test.amount, got.Exp)
+			}
+			if formatted := formatAmount(got); formatted != test.want {
+				t.Errorf("parseAmount(%q) = %s, want %s", test.amount, formatted, test.want)
+			}
+		})
+	}
+}
+
+func TestToCents(t *testing.T) {
+	tests := []struct {
+		name      string
+		numeric   pgtype.Numeric
+		want      int64
+		wantExact bool
+	}{
+		{name: "zero", numeric: numeric(0, 0), want: 0, wantExact: true},
+		{name: "integer", numeric: numeric(12, 0), want: 1200, wantExact: true},
+		{name: "cents", numeric: numeric(1234, -2), want: 1234, wantExact: true},
+		{name: "positive exponent", numeric: numeric(15, 1), want: 15000, wantExact: true},
+		{name: "scale 4", numeric: numeric(123400, -4), want: 1234, wantExact: true},
+		{name: "scale 3", numeric: numeric(1005, -3), want: 100, wantExact: false},
+		{name: "negative", numeric: numeric(-1234, -2), want: -1234, wantExact: true},
+		{name: "negative scale 3", numeric: numeric(-1005, -3), want: -100, wantExact: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, exact := toCents(test.numeric)
+			if got.Int64() != test.want || exact != test.wantExact {
+				t.Errorf("toCents() = %s, %t, want %d, %t", got, exact, test.want, test.wantExact)
+			}
+		})
+	}
+}
+
+func TestToCentsKeepsInput(t *testing.T) {
+	amount := numeric(1234, 0)
+	toCents(amount)
+
+	if amount.Int.Int64() != 1234 {
+		t.Errorf("toCents() changed its input to %s", amount.Int)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		numeric pgtype.Numeric
+		want    string
+	}{
+		{name: "zero", numeric: numeric(0, 0), want: "0.00"},
+		{name: "zero cents", numeric: numeric(0, -2), want: "0.00"},
+		{name: "cents", numeric: numeric(5, -2), want: "0.05"},
+		{name: "dimes", numeric: numeric(50, -2), want: "0.50"},
+		{name: "integer", numeric: numeric(1000, 0), want: "1000.00"},
+		{name: "positive exponent", numeric: numeric(25, 3), want: "25000.00"},
+		{name: "scale 4", numeric: numeric(123400, -4), want: "12.34"},
+		{name: "negative", numeric: numeric(-1234, -2), want: "-12.34"},
+		{name: "negative cents", numeric: numeric(-5, -2), want: "-0.05"},
+		{name: "null", numeric: pgtype.Numeric{}, want: ""},
+		{name: "NaN", numeric: pgtype.Numeric{NaN: true, Valid: true}, want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := formatAmount(test.numeric); got != test.want {
+				t.Errorf("formatAmount() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
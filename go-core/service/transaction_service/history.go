@@ -0,0 +1,255 @@
+package transaction_service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Page sizes of the transaction history
+const (
+	defaultHistoryPageSize = 50
+	maxHistoryPageSize     = 500
+)
+
+type GetTransactionHistoryParams struct {
+	AccountNumber   string
+	FromTime        string // Inclusive, RFC 3339 or YYYY-MM-DD
+	ToTime          string // Exclusive RFC 3339, a YYYY-MM-DD date includes the whole day
+	TransactionType string
+	PageSize        int32
+	PageToken       string
+}
+
+type GetTransactionHistoryResult struct {
+	Transactions  []Transaction
+	NextPageToken string
+}
+
+// historyQuery is the validated form of GetTransactionHistoryParams
+type historyQuery struct {
+	transactionType pgtype.Text
+	fromTime        pgtype.Timestamp
+	toTime          pgtype.Timestamp
+	cursorTime      pgtype.Timestamp
+	cursorID        pgtype.Int8
+	pageSize        int32
+}
+
+func (service *Service) GetTransactionHistory(ctx context.Context, params *GetTransactionHistoryParams) (*GetTransactionHistoryResult, error) {
+	const op = "transaction_service.Service.GetTransactionHistory"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &GetTransactionHistoryResult{
+		Transactions: []Transaction{},
+	}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	query, err := newHistoryQuery(params)
+	if err != nil {
+		appErr := apperrors.Wrap(apperrors.ErrorCodeValidation, err, err.Error())
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Validate params",
+			"err":   appErr.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(appErr)
+		span.SetStatus(codes.Error, appErr.Error())
+
+		return nil, appErr
+	}
+
+	accountID, err := service.store.postgres.GetAccountIDByAccountNumber(ctx, params.AccountNumber)
+	if err != nil {
+		if apperrors.IsCode(err, apperrors.ErrorCodeNotFound) {
+			err = apperrors.Newf(apperrors.ErrorCodeNotFound, "account %s not found", params.AccountNumber)
+		}
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Get account id",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// One extra row tells whether there is a next page
+	rows, err := service.store.postgres.GetTransactionHistory(ctx, sqlc.GetTransactionHistoryParams{
+		AccountID:       accountID,
+		TransactionType: query.transactionType,
+		FromTime:        query.fromTime,
+		ToTime:          query.toTime,
+		CursorTime:      query.cursorTime,
+		CursorID:        query.cursorID,
+		PageSize:        query.pageSize + 1,
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Get transaction history",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if len(rows) > int(query.pageSize) {
+		rows = rows[:query.pageSize]
+
+		last := rows[len(rows)-1]
+		result.NextPageToken = encodePageToken(last.TransactionTime.Time, last.TransactionID)
+	}
+
+	// Map sqlc rows to domain models
+	for _, row := range rows {
+		result.Transactions = append(result.Transactions, Transaction{
+			TransactionID:   row.TransactionID,
+			AccountNumber:   params.AccountNumber,
+			TransactionType: row.TransactionType,
+			Amount:          formatAmount(row.Amount),
+			BalanceBefore:   formatAmount(row.BalanceBefore),
+			BalanceAfter:    formatAmount(row.BalanceAfter),
+			TransactionTime: formatTimestamp(row.TransactionTime),
+			ReferenceNumber: formatText(row.ReferenceNumber),
+			Description:     formatText(row.Description),
+		})
+	}
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.Int("output.transactions", len(result.Transactions)),
+		attribute.Bool("output.has_next_page", result.NextPageToken != ""),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
+
+// newHistoryQuery validates the filters, page size and page token
+func newHistoryQuery(params *GetTransactionHistoryParams) (*historyQuery, error) {
+	query := &historyQuery{
+		transactionType: toText(strings.ToUpper(params.TransactionType)),
+		pageSize:        params.PageSize,
+	}
+
+	if params.AccountNumber == "" {
+		return nil, fmt.Errorf("account_number is required")
+	}
+
+	switch {
+	case query.pageSize == 0:
+		query.pageSize = defaultHistoryPageSize
+	case query.pageSize < 0 || query.pageSize > maxHistoryPageSize:
+		return nil, fmt.Errorf("page_size must be between 1 and %d", maxHistoryPageSize)
+	}
+
+	var err error
+	if params.FromTime != "" {
+		if query.fromTime, err = parseTime(params.FromTime, false); err != nil {
+			return nil, fmt.Errorf("invalid from_time: %w", err)
+		}
+	}
+	if params.ToTime != "" {
+		if query.toTime, err = parseTime(params.ToTime, true); err != nil {
+			return nil, fmt.Errorf("invalid to_time: %w", err)
+		}
+	}
+
+	if params.PageToken != "" {
+		at, id, err := decodePageToken(params.PageToken)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page_token")
+		}
+		query.cursorTime = pgtype.Timestamp{Time: at, Valid: true}
+		query.cursorID = pgtype.Int8{Int64: id, Valid: true}
+	}
+
+	return query, nil
+}
+
+// parseTime parses an RFC 3339 time or a YYYY-MM-DD date. An end date is
+// moved to the start of the next day so the whole day is included.
+func parseTime(value string, end bool) (pgtype.Timestamp, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		if end {
+			date = date.AddDate(0, 0, 1)
+		}
+		return pgtype.Timestamp{Time: date, Valid: true}, nil
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return pgtype.Timestamp{}, fmt.Errorf("expected RFC 3339 or YYYY-MM-DD, got %q", value)
+	}
+
+	// transaction_time has no time zone and is written in UTC
+	return pgtype.Timestamp{Time: at.UTC(), Valid: true}, nil
+}
+
+// encodePageToken encodes the keyset cursor of the last row of a page
+func encodePageToken(at time.Time, transactionID int64) string {
+	cursor := strconv.FormatInt(at.UnixMicro(), 10) + ":" + strconv.FormatInt(transactionID, 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodePageToken(token string) (time.Time, int64, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	micros, id, ok := strings.Cut(string(cursor), ":")
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("malformed cursor")
+	}
+
+	at, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	transactionID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	return time.UnixMicro(at).UTC(), transactionID, nil
+}
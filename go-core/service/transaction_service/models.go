@@ -0,0 +1,23 @@
+package transaction_service
+
+// Transaction types written to demo.transaction_log
+const (
+	TransactionTypeDebit  = "DEBIT"
+	TransactionTypeCredit = "CREDIT"
+)
+
+// AccountStatusActive is the only status that accepts debits and credits
+const AccountStatusActive = "ACTIVE"
+
+// Transaction represents the domain model for a transaction log entry
+type Transaction struct {
+	TransactionID   int64
+	AccountNumber   string
+	TransactionType string
+	Amount          string
+	BalanceBefore   string
+	BalanceAfter    string
+	TransactionTime string
+	ReferenceNumber string
+	Description     string
+}
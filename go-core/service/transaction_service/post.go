@@ -0,0 +1,194 @@
+package transaction_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxReferenceNumberLength is the size of demo.transaction_log.reference_number
+const maxReferenceNumberLength = 50
+
+// entry is one balance change of a posting
+type entry struct {
+	accountNumber   string
+	transactionType string
+	amount          pgtype.Numeric // Always positive, the type gives the direction
+}
+
+// errDuplicateReference is returned by the transaction when a concurrent
+// request with the same reference number committed first
+var errDuplicateReference = errors.New("duplicate reference number")
+
+// post applies entries in one serializable transaction and logs each of them
+// under referenceNumber. A reference number that was already posted with the
+// same entries returns the original transactions instead; with other entries
+// it is a conflict.
+func (service *Service) post(ctx context.Context, referenceNumber string, description string, entries []entry) ([]Transaction, error) {
+	if referenceNumber == "" {
+		return nil, apperrors.New(apperrors.ErrorCodeValidation, "reference_number is required")
+	}
+	if len(referenceNumber) > maxReferenceNumberLength {
+		return nil, apperrors.Newf(apperrors.ErrorCodeValidation, "reference_number must be at most %d characters", maxReferenceNumberLength)
+	}
+
+	accountNumbers := make([]string, 0, len(entries))
+	for _, e := range entries {
+		accountNumbers = append(accountNumbers, e.accountNumber)
+	}
+
+	var transactions []Transaction
+
+	_, err := service.store.postgres.WithTx(ctx, &postgres_store.WithTxArgs{
+		Invalidates: accountNumbers,
+		Fn: func(q *sqlc.Queries) error {
+			existing, err := q.GetTransactionLogsByReferenceNumber(ctx, toText(referenceNumber))
+			if err != nil {
+				return err
+			}
+			if len(existing) > 0 {
+				transactions, err = replay(existing, entries)
+				return err
+			}
+
+			transactions, err = apply(ctx, q, referenceNumber, description, entries)
+			return err
+		},
+	})
+	if errors.Is(err, errDuplicateReference) {
+		// Lost the race against the same request, answer like a retry would
+		existing, err := service.store.postgres.GetTransactionLogsByReferenceNumber(ctx, toText(referenceNumber))
+		if err != nil {
+			return nil, err
+		}
+
+		return replay(existing, entries)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// apply locks the accounts of entries, changes their balances and logs the entries
+func apply(ctx context.Context, q *sqlc.Queries, referenceNumber string, description string, entries []entry) ([]Transaction, error) {
+	accountNumbers := make([]string, 0, len(entries))
+	for _, e := range entries {
+		accountNumbers = append(accountNumbers, e.accountNumber)
+	}
+
+	rows, err := q.LockAccountsByAccountNumbers(ctx, accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[string]sqlc.LockAccountsByAccountNumbersRow, len(rows))
+	for _, row := range rows {
+		accounts[row.AccountNumber] = row
+	}
+
+	currency := ""
+	for _, e := range entries {
+		account, ok := accounts[e.accountNumber]
+		if !ok {
+			return nil, apperrors.Newf(apperrors.ErrorCodeNotFound, "account %s not found", e.accountNumber)
+		}
+		if account.AccountStatus != AccountStatusActive {
+			return nil, apperrors.Newf(apperrors.ErrorCodeValidation, "account %s is %s", e.accountNumber, account.AccountStatus)
+		}
+		if currency != "" && account.Currency != currency {
+			return nil, apperrors.New(apperrors.ErrorCodeValidation, "accounts have different currencies")
+		}
+		currency = account.Currency
+	}
+
+	transactions := make([]Transaction, 0, len(entries))
+	for _, e := range entries {
+		account := accounts[e.accountNumber]
+
+		change := e.amount
+		if e.transactionType == TransactionTypeDebit {
+			change = negate(e.amount)
+		}
+
+		// check_balance_non_negative rejects debits beyond the balance
+		balanceAfter, err := q.AddAccountBalance(ctx, sqlc.AddAccountBalanceParams{
+			Amount:    change,
+			AccountID: account.AccountID,
+		})
+		if err != nil {
+			if apperrors.IsCode(err, apperrors.ErrorCodeConstraintViolation) {
+				return nil, apperrors.Wrapf(apperrors.ErrorCodeConstraintViolation, err, "insufficient balance in account %s", e.accountNumber)
+			}
+			return nil, err
+		}
+
+		row, err := q.CreateTransactionLog(ctx, sqlc.CreateTransactionLogParams{
+			AccountID:       account.AccountID,
+			TransactionType: e.transactionType,
+			Amount:          e.amount,
+			BalanceBefore:   account.Balance,
+			BalanceAfter:    balanceAfter,
+			ReferenceNumber: toText(referenceNumber),
+			Description:     toText(description),
+		})
+		if err != nil {
+			if apperrors.IsCode(err, apperrors.ErrorCodeConflict) {
+				return nil, fmt.Errorf("%w: %w", errDuplicateReference, err)
+			}
+			return nil, err
+		}
+
+		transactions = append(transactions, Transaction{
+			TransactionID:   row.TransactionID,
+			AccountNumber:   account.AccountNumber,
+			TransactionType: e.transactionType,
+			Amount:          formatAmount(e.amount),
+			BalanceBefore:   formatAmount(account.Balance),
+			BalanceAfter:    formatAmount(balanceAfter),
+			TransactionTime: formatTimestamp(row.TransactionTime),
+			ReferenceNumber: referenceNumber,
+			Description:     description,
+		})
+	}
+
+	return transactions, nil
+}
+
+// replay returns the logged transactions of a reference number if they match entries
+func replay(existing []sqlc.GetTransactionLogsByReferenceNumberRow, entries []entry) ([]Transaction, error) {
+	mismatch := apperrors.New(apperrors.ErrorCodeConflict, "reference_number was already used for a different transaction")
+
+	if len(existing) != len(entries) {
+		return nil, mismatch
+	}
+
+	transactions := make([]Transaction, 0, len(existing))
+	for i, row := range existing {
+		e := entries[i]
+		if row.AccountNumber != e.accountNumber || row.TransactionType != e.transactionType || !amountsEqual(row.Amount, e.amount) {
+			return nil, mismatch
+		}
+
+		transactions = append(transactions, Transaction{
+			TransactionID:   row.TransactionID,
+			AccountNumber:   row.AccountNumber,
+			TransactionType: row.TransactionType,
+			Amount:          formatAmount(row.Amount),
+			BalanceBefore:   formatAmount(row.BalanceBefore),
+			BalanceAfter:    formatAmount(row.BalanceAfter),
+			TransactionTime: formatTimestamp(row.TransactionTime),
+			ReferenceNumber: formatText(row.ReferenceNumber),
+			Description:     formatText(row.Description),
+		})
+	}
+
+	return transactions, nil
+}
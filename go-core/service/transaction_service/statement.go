@@ -0,0 +1,227 @@
+package transaction_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Batch sizes of the statement stream
+const (
+	defaultStatementBatchSize = 100
+	maxStatementBatchSize     = 1000
+)
+
+type StreamStatementParams struct {
+	AccountNumber string
+	FromTime      string // Inclusive, RFC 3339 or YYYY-MM-DD
+	ToTime        string // Exclusive RFC 3339, a YYYY-MM-DD date includes the whole day
+	BatchSize     int32
+}
+
+type StreamStatementResult struct {
+	Transactions int
+	Batches      int
+}
+
+// StreamStatement reads the transactions of an account oldest first, one
+// batch per query, and passes each batch to send. The next batch is only read
+// once send returned, so a slow receiver slows down the reads instead of
+// piling up rows. The stream stops at the first error of send or when ctx is done.
+//
+// All batches are read in one read-only repeatable read transaction on the
+// primary, so the statement is a single consistent snapshot: rows committed
+// or replicated while it streams can neither be skipped nor duplicated.
+// Read-only transactions do not fail on serialization, so the transaction is
+// not retried and no batch is sent twice.
+func (service *Service) StreamStatement(ctx context.Context, params *StreamStatementParams, send func([]Transaction) error) (*StreamStatementResult, error) {
+	const op = "transaction_service.Service.StreamStatement"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &StreamStatementResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	cursor, err := newStatementCursor(params)
+	if err != nil {
+		appErr := apperrors.Wrap(apperrors.ErrorCodeValidation, err, err.Error())
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Validate params",
+			"err":   appErr.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(appErr)
+		span.SetStatus(codes.Error, appErr.Error())
+
+		return nil, appErr
+	}
+
+	_, err = service.store.postgres.WithTxOptions(ctx, &postgres_store.WithTxOptionsArgs{
+		Opts: postgres_store.ReadOnlyTxOptions(),
+		Fn: func(q *sqlc.Queries) error {
+			accountID, err := q.GetAccountIDByAccountNumber(ctx, params.AccountNumber)
+			if err != nil {
+				if apperrors.IsCode(err, apperrors.ErrorCodeNotFound) {
+					return apperrors.Newf(apperrors.ErrorCodeNotFound, "account %s not found", params.AccountNumber)
+				}
+				return err
+			}
+			cursor.AccountID = accountID
+
+			return service.streamStatementBatches(ctx, q.GetStatementBatch, cursor, params.AccountNumber, send, result)
+		},
+	})
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.Int("output.transactions", result.Transactions),
+		attribute.Int("output.batches", result.Batches),
+	)
+
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope":        "Stream statement",
+			"transactions": result.Transactions,
+			"err":          err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
+
+// statementBatchQuery reads the batch of a statement after the cursor
+type statementBatchQuery func(ctx context.Context, cursor sqlc.GetStatementBatchParams) ([]sqlc.GetStatementBatchRow, error)
+
+// streamStatementBatches sends batches from cursor until a batch is not full
+func (service *Service) streamStatementBatches(ctx context.Context, query statementBatchQuery, cursor *sqlc.GetStatementBatchParams, accountNumber string, send func([]Transaction) error, result *StreamStatementResult) error {
+	for {
+		if err := service.streamStatementBatch(ctx, query, cursor, accountNumber, send, result); err != nil {
+			return err
+		}
+		if !cursor.CursorID.Valid {
+			return nil
+		}
+	}
+}
+
+// streamStatementBatch reads and sends the batch after cursor, then moves the
+// cursor to its last row. The cursor is cleared after the last batch.
+func (service *Service) streamStatementBatch(ctx context.Context, query statementBatchQuery, cursor *sqlc.GetStatementBatchParams, accountNumber string, send func([]Transaction) error, result *StreamStatementResult) error {
+	switch err := ctx.Err(); {
+	case errors.Is(err, context.Canceled):
+		return apperrors.Wrap(apperrors.ErrorCodeCanceled, err, "statement stream canceled")
+	case err != nil:
+		return apperrors.Wrap(apperrors.ErrorCodeTimeout, err, "statement stream timed out")
+	}
+
+	rows, err := query(ctx, *cursor)
+	if err != nil {
+		return err
+	}
+
+	if len(rows) < int(cursor.BatchSize) {
+		cursor.CursorID = pgtype.Int8{}
+	} else {
+		last := rows[len(rows)-1]
+		cursor.CursorTime = last.TransactionTime
+		cursor.CursorID = pgtype.Int8{Int64: last.TransactionID, Valid: true}
+	}
+
+	// An empty statement still gets one, empty, batch
+	if len(rows) == 0 && result.Batches > 0 {
+		return nil
+	}
+
+	// Map sqlc rows to domain models
+	transactions := make([]Transaction, 0, len(rows))
+	for _, row := range rows {
+		transactions = append(transactions, Transaction{
+			TransactionID:   row.TransactionID,
+			AccountNumber:   accountNumber,
+			TransactionType: row.TransactionType,
+			Amount:          formatAmount(row.Amount),
+			BalanceBefore:   formatAmount(row.BalanceBefore),
+			BalanceAfter:    formatAmount(row.BalanceAfter),
+			TransactionTime: formatTimestamp(row.TransactionTime),
+			ReferenceNumber: formatText(row.ReferenceNumber),
+			Description:     formatText(row.Description),
+		})
+	}
+
+	if err := send(transactions); err != nil {
+		return err
+	}
+
+	result.Transactions += len(transactions)
+	result.Batches++
+
+	return nil
+}
+
+// newStatementCursor validates the date range and batch size
+func newStatementCursor(params *StreamStatementParams) (*sqlc.GetStatementBatchParams, error) {
+	cursor := &sqlc.GetStatementBatchParams{
+		BatchSize: params.BatchSize,
+	}
+
+	if params.AccountNumber == "" {
+		return nil, fmt.Errorf("account_number is required")
+	}
+
+	switch {
+	case cursor.BatchSize == 0:
+		cursor.BatchSize = defaultStatementBatchSize
+	case cursor.BatchSize < 0 || cursor.BatchSize > maxStatementBatchSize:
+		return nil, fmt.Errorf("batch_size must be between 1 and %d", maxStatementBatchSize)
+	}
+
+	var err error
+	if params.FromTime != "" {
+		if cursor.FromTime, err = parseTime(params.FromTime, false); err != nil {
+			return nil, fmt.Errorf("invalid from_time: %w", err)
+		}
+	}
+	if params.ToTime != "" {
+		if cursor.ToTime, err = parseTime(params.ToTime, true); err != nil {
+			return nil, fmt.Errorf("invalid to_time: %w", err)
+		}
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,214 @@
+package transaction_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-core/store/postgres_store/sqlc"
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestNewStatementCursor(t *testing.T) {
+	from := pgtype.Timestamp{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	to := pgtype.Timestamp{Time: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	tests := []struct {
+		name    string
+		params  StreamStatementParams
+		want    sqlc.GetStatementBatchParams
+		wantErr bool
+	}{
+		{
+			name:   "defaults",
+			params: StreamStatementParams{AccountNumber: "1001000000001"},
+			want:   sqlc.GetStatementBatchParams{BatchSize: defaultStatementBatchSize},
+		},
+		{
+			name:   "dates",
+			params: StreamStatementParams{AccountNumber: "1001000000001", FromTime: "2024-01-01", ToTime: "2024-01-31", BatchSize: 10},
+			want:   sqlc.GetStatementBatchParams{FromTime: from, ToTime: to, BatchSize: 10},
+		},
+		{
+			name:   "RFC 3339",
+			params: StreamStatementParams{AccountNumber: "1001000000001", FromTime: "2024-01-01T07:00:00+07:00", ToTime: "2024-02-01T00:00:00Z"},
+			want:   sqlc.GetStatementBatchParams{FromTime: from, ToTime: to, BatchSize: defaultStatementBatchSize},
+		},
+		{
+			name:   "largest batch",
+			params: StreamStatementParams{AccountNumber: "1001000000001", BatchSize: maxStatementBatchSize},
+			want:   sqlc.GetStatementBatchParams{BatchSize: maxStatementBatchSize},
+		},
+		{name: "no account", params: StreamStatementParams{}, wantErr: true},
+		{name: "negative batch", params: StreamStatementParams{AccountNumber: "1001000000001", BatchSize: -1}, wantErr: true},
+		{name: "batch too large", params: StreamStatementParams{AccountNumber: "1001000000001", BatchSize: maxStatementBatchSize + 1}, wantErr: true},
+		{name: "invalid from", params: StreamStatementParams{AccountNumber: "1001000000001", FromTime: "yesterday"}, wantErr: true},
+		{name: "invalid to", params: StreamStatementParams{AccountNumber: "1001000000001", ToTime: "2024-13-01"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := newStatementCursor(&test.params)
+
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("newStatementCursor() = %+v, want error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("newStatementCursor() error = %v", err)
+			}
+			if *got != test.want {
+				t.Errorf("newStatementCursor() = %+v, want %+v", *got, test.want)
+			}
+		})
+	}
+}
+
+// statementRows returns n rows one minute apart, with pairs sharing a time so
+// the cursor has to break ties on transaction_id
+func statementRows(n int) []sqlc.GetStatementBatchRow {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := make([]sqlc.GetStatementBatchRow, n)
+	for i := range rows {
+		rows[i] = sqlc.GetStatementBatchRow{
+			TransactionID:   int64(i + 1),
+			TransactionType: "CREDIT",
+			Amount:          numeric(100, -2),
+			TransactionTime: pgtype.Timestamp{Time: start.Add(time.Duration(i/2) * time.Minute), Valid: true},
+		}
+	}
+
+	return rows
+}
+
+// fakeStatementQuery serves rows, ordered by (transaction_time,
+// transaction_id), like GetStatementBatch. It fails once called more than
+// maxCalls times, so a stream that does not end fails the test.
+func fakeStatementQuery(rows []sqlc.GetStatementBatchRow, maxCalls int, calls *int) statementBatchQuery {
+	return func(ctx context.Context, cursor sqlc.GetStatementBatchParams) ([]sqlc.GetStatementBatchRow, error) {
+		*calls++
+		if *calls > maxCalls {
+			return nil, fmt.Errorf("query called %d times", *calls)
+		}
+
+		var batch []sqlc.GetStatementBatchRow
+		for _, row := range rows {
+			if cursor.CursorTime.Valid {
+				after := row.TransactionTime.Time.After(cursor.CursorTime.Time) ||
+					(row.TransactionTime.Time.Equal(cursor.CursorTime.Time) && row.TransactionID > cursor.CursorID.Int64)
+				if !after {
+					continue
+				}
+			}
+			if len(batch) == int(cursor.BatchSize) {
+				break
+			}
+			batch = append(batch, row)
+		}
+
+		return batch, nil
+	}
+}
+
+func TestStreamStatementBatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        int
+		batchSize   int32
+		wantBatches []int // Sizes of the batches sent
+		wantQueries int
+	}{
+		{name: "empty", rows: 0, batchSize: 2, wantBatches: []int{0}, wantQueries: 1},
+		{name: "partial batch", rows: 1, batchSize: 2, wantBatches: []int{1}, wantQueries: 1},
+		{name: "full batches", rows: 4, batchSize: 2, wantBatches: []int{2, 2}, wantQueries: 3},
+		{name: "last batch partial", rows: 5, batchSize: 2, wantBatches: []int{2, 2, 1}, wantQueries: 3},
+		{name: "single row batches", rows: 3, batchSize: 1, wantBatches: []int{1, 1, 1}, wantQueries: 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int
+			query := fakeStatementQuery(statementRows(test.rows), 10, &calls)
+
+			var sent []int
+			var ids []int64
+			send := func(transactions []Transaction) error {
+				sent = append(sent, len(transactions))
+				for _, transaction := range transactions {
+					ids = append(ids, transaction.TransactionID)
+				}
+				return nil
+			}
+
+			cursor := &sqlc.GetStatementBatchParams{BatchSize: test.batchSize}
+			result := &StreamStatementResult{}
+
+			err := (&Service{}).streamStatementBatches(context.Background(), query, cursor, "1001000000001", send, result)
+			if err != nil {
+				t.Fatalf("streamStatementBatches() error = %v", err)
+			}
+
+			if fmt.Sprint(sent) != fmt.Sprint(test.wantBatches) {
+				t.Errorf("batches = %v, want %v", sent, test.wantBatches)
+			}
+			if calls != test.wantQueries {
+				t.Errorf("queries = %d, want %d", calls, test.wantQueries)
+			}
+			for i, id := range ids {
+				if id != int64(i+1) {
+					t.Fatalf("transaction %d = %d, want every transaction once in order, got %v", i, id, ids)
+				}
+			}
+			if result.Transactions != test.rows || result.Batches != len(test.wantBatches) {
+				t.Errorf("result = %+v, want %d transactions in %d batches", result, test.rows, len(test.wantBatches))
+			}
+		})
+	}
+}
+
+func TestStreamStatementBatchesStops(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errSend := errors.New("send failed")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		send     error
+		wantCode apperrors.ErrorCode
+		wantErr  error
+	}{
+		{name: "canceled", ctx: canceled, wantCode: apperrors.ErrorCodeCanceled},
+		{name: "send error", ctx: context.Background(), send: errSend, wantErr: errSend},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int
+			query := fakeStatementQuery(statementRows(4), 10, &calls)
+			send := func([]Transaction) error { return test.send }
+
+			cursor := &sqlc.GetStatementBatchParams{BatchSize: 2}
+			err := (&Service{}).streamStatementBatches(test.ctx, query, cursor, "1001000000001", send, &StreamStatementResult{})
+
+			if test.wantCode != "" && !apperrors.IsCode(err, test.wantCode) {
+				t.Errorf("streamStatementBatches() error = %v, want code %s", err, test.wantCode)
+			}
+			if test.wantErr != nil && !errors.Is(err, test.wantErr) {
+				t.Errorf("streamStatementBatches() error = %v, want %v", err, test.wantErr)
+			}
+			if calls > 1 {
+				t.Errorf("queries = %d after the stream stopped, want at most 1", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package transaction_service
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "go-core/util/errors"
+	"go-core/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type TransferParams struct {
+	FromAccountNumber string
+	ToAccountNumber   string
+	Amount            string
+	ReferenceNumber   string
+	Description       string
+}
+
+type TransferResult struct {
+	Debit  Transaction
+	Credit Transaction
+}
+
+// Transfer debits one account and credits another in the same transaction.
+// Both log entries share the reference number.
+func (service *Service) Transfer(ctx context.Context, params *TransferParams) (*TransferResult, error) {
+	const op = "transaction_service.Service.Transfer"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &TransferResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	amount, err := parseAmount(params.Amount)
+	if err == nil && params.FromAccountNumber == params.ToAccountNumber {
+		err = fmt.Errorf("from_account_number and to_account_number must differ")
+	}
+	if err != nil {
+		appErr := apperrors.Wrap(apperrors.ErrorCodeValidation, err, err.Error())
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Validate params",
+			"err":   appErr.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(appErr)
+		span.SetStatus(codes.Error, appErr.Error())
+
+		return nil, appErr
+	}
+
+	description := params.Description
+	if description == "" {
+		description = "Transfer via API"
+	}
+
+	transactions, err := service.post(ctx, params.ReferenceNumber, description, []entry{
+		{accountNumber: params.FromAccountNumber, transactionType: TransactionTypeDebit, amount: amount},
+		{accountNumber: params.ToAccountNumber, transactionType: TransactionTypeCredit, amount: amount},
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Post transfer",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	result.Debit = transactions[0]
+	result.Credit = transactions[1]
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
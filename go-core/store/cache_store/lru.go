@@ -0,0 +1,92 @@
+package cache_store
+
+import (
+	"container/list"
+	"time"
+
+	"go-core/store/postgres_store/sqlc"
+)
+
+// entry is a cached account inquiry row
+type entry struct {
+	accountNumber string
+	row           sqlc.GetAccountByAccountNumberRow
+	expiresAt     time.Time
+}
+
+// lru is a size- and TTL-bounded least recently used cache of account rows.
+// It is not safe for concurrent use.
+type lru struct {
+	maxEntries int
+	ttl        time.Duration
+
+	items map[string]*list.Element
+	order *list.List // Most recently used first
+}
+
+func newLRU(maxEntries int, ttl time.Duration) *lru {
+	return &lru{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// get returns the row of an account, expired rows are removed
+func (cache *lru) get(accountNumber string, now time.Time) (sqlc.GetAccountByAccountNumberRow, bool) {
+	element, ok := cache.items[accountNumber]
+	if !ok {
+		return sqlc.GetAccountByAccountNumberRow{}, false
+	}
+
+	e := element.Value.(*entry)
+	if now.After(e.expiresAt) {
+		cache.removeElement(element)
+		return sqlc.GetAccountByAccountNumberRow{}, false
+	}
+
+	cache.order.MoveToFront(element)
+
+	return e.row, true
+}
+
+// add stores the row of an account, reporting whether an entry was evicted
+func (cache *lru) add(accountNumber string, row sqlc.GetAccountByAccountNumberRow, now time.Time) bool {
+	if element, ok := cache.items[accountNumber]; ok {
+		e := element.Value.(*entry)
+		e.row = row
+		e.expiresAt = now.Add(cache.ttl)
+		cache.order.MoveToFront(element)
+		return false
+	}
+
+	cache.items[accountNumber] = cache.order.PushFront(&entry{
+		accountNumber: accountNumber,
+		row:           row,
+		expiresAt:     now.Add(cache.ttl),
+	})
+
+	if cache.order.Len() > cache.maxEntries {
+		cache.removeElement(cache.order.Back())
+		return true
+	}
+
+	return false
+}
+
+// remove drops the row of an account
+func (cache *lru) remove(accountNumber string) {
+	if element, ok := cache.items[accountNumber]; ok {
+		cache.removeElement(element)
+	}
+}
+
+func (cache *lru) removeElement(element *list.Element) {
+	e := cache.order.Remove(element).(*entry)
+	delete(cache.items, e.accountNumber)
+}
+
+func (cache *lru) len() int {
+	return cache.order.Len()
+}
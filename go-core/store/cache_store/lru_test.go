@@ -0,0 +1,100 @@
+package cache_store
+
+import (
+	"testing"
+	"time"
+
+	"go-core/store/postgres_store/sqlc"
+)
+
+func row(accountNumber string) sqlc.GetAccountByAccountNumberRow {
+	return sqlc.GetAccountByAccountNumberRow{AccountNumber: accountNumber}
+}
+
+func TestLRUExpiry(t *testing.T) {
+	now := time.Now()
+	cache := newLRU(10, time.Second)
+	cache.add("A", row("A"), now)
+
+	tests := []struct {
+		name  string
+		at    time.Time
+		found bool
+	}{
+		{"fresh", now, true},
+		{"at ttl", now.Add(time.Second), true},
+		{"expired", now.Add(time.Second + time.Nanosecond), false},
+		{"removed once expired", now, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, found := cache.get("A", test.at); found != test.found {
+				t.Errorf("get() found = %t, want %t", found, test.found)
+			}
+		})
+	}
+}
+
+func TestLRUAddRefreshesExpiry(t *testing.T) {
+	now := time.Now()
+	cache := newLRU(10, time.Second)
+	cache.add("A", row("A"), now)
+	cache.add("A", row("A"), now.Add(time.Second))
+
+	if _, found := cache.get("A", now.Add(1500*time.Millisecond)); !found {
+		t.Errorf("get() found = false after the entry was refreshed")
+	}
+	if cache.len() != 1 {
+		t.Errorf("len() = %d, want 1", cache.len())
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	now := time.Now()
+	cache := newLRU(2, time.Minute)
+
+	if cache.add("A", row("A"), now) || cache.add("B", row("B"), now) {
+		t.Fatalf("add() evicted below the maximum")
+	}
+
+	// A becomes the most recently used, so B is evicted
+	cache.get("A", now)
+	if !cache.add("C", row("C"), now) {
+		t.Fatalf("add() = false above the maximum, want an eviction")
+	}
+
+	for _, test := range []struct {
+		accountNumber string
+		found         bool
+	}{
+		{"A", true},
+		{"B", false},
+		{"C", true},
+	} {
+		if _, found := cache.get(test.accountNumber, now); found != test.found {
+			t.Errorf("get(%s) found = %t, want %t", test.accountNumber, found, test.found)
+		}
+	}
+
+	// Updating a cached account does not evict
+	if cache.add("A", row("A"), now) {
+		t.Errorf("add() of a cached account evicted an entry")
+	}
+}
+
+func TestLRURemove(t *testing.T) {
+	now := time.Now()
+	cache := newLRU(10, time.Minute)
+	cache.add("A", row("A"), now)
+
+	cache.remove("A")
+	cache.remove("B")
+
+	if _, found := cache.get("A", now); found {
+		t.Errorf("get() found a removed account")
+	}
+	if cache.len() != 0 {
+		t.Errorf("len() = %d, want 0", cache.len())
+	}
+}
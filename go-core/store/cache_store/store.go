@@ -0,0 +1,266 @@
+package cache_store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+// Defaults of the cache options
+const (
+	defaultMaxEntries  = 10000
+	defaultTTL         = 5 * time.Second
+	defaultFillTimeout = 5 * time.Second
+)
+
+// Options bounds the account cache
+type Options struct {
+	MaxEntries    int           // Cached accounts, least recently used are evicted first
+	TTL           time.Duration // Time a cached account is served
+	StatsInterval time.Duration // Interval between stats logs, disabled if 0
+	FillTimeout   time.Duration // Bound on the query filling a miss, shared by every caller waiting on it
+}
+
+// Stats counts cache lookups and removals since the store was created
+type Stats struct {
+	Hits          int64
+	Misses        int64
+	Evictions     int64
+	Invalidations int64
+	Entries       int
+}
+
+// Store decorates an IStore with a read-through cache of account inquiries.
+// Concurrent misses for the same account share one query, which reads from the
+// primary so a refill after an invalidation cannot cache a lagging replica
+// row. The query is not canceled with the caller that started it, as the
+// others wait on it too. Accounts are dropped from the cache only after a
+// transaction, for the account numbers it declares in Invalidates; balance
+// changes must therefore go through WithTx or WithTxOptions.
+type Store struct {
+	postgres_store.IStore
+
+	logger *logrus.Logger
+	tracer trace.Tracer
+
+	group       singleflight.Group
+	fillTimeout time.Duration
+
+	mutex sync.Mutex
+	cache *lru
+	// fills in flight by account number, true once invalidated so the
+	// possibly stale row they read is not cached
+	fills map[string]bool
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	evictions     atomic.Int64
+	invalidations atomic.Int64
+
+	// stop ends the stats logs, done is closed once they ended
+	stop chan struct{}
+	done chan struct{}
+}
+
+func NewStore(logger *logrus.Logger, tracer trace.Tracer, store postgres_store.IStore, options Options) postgres_store.IStore {
+	if options.MaxEntries <= 0 {
+		options.MaxEntries = defaultMaxEntries
+	}
+	if options.TTL <= 0 {
+		options.TTL = defaultTTL
+	}
+	if options.FillTimeout <= 0 {
+		options.FillTimeout = defaultFillTimeout
+	}
+
+	cached := &Store{
+		IStore: store,
+
+		logger: logger,
+		tracer: tracer,
+
+		fillTimeout: options.FillTimeout,
+
+		cache: newLRU(options.MaxEntries, options.TTL),
+		fills: make(map[string]bool),
+
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	if options.StatsInterval > 0 {
+		go cached.logStats(options.StatsInterval)
+	} else {
+		close(cached.done)
+	}
+
+	return cached
+}
+
+// GetAccountByAccountNumber serves the account from the cache, querying the
+// decorated store on a miss
+func (store *Store) GetAccountByAccountNumber(ctx context.Context, accountNumber string) (sqlc.GetAccountByAccountNumberRow, error) {
+	const op = "cache_store.Store.GetAccountByAccountNumber"
+
+	// Start span
+	ctx, span := store.tracer.Start(ctx, op)
+	defer span.End()
+
+	store.mutex.Lock()
+	row, ok := store.cache.get(accountNumber, time.Now())
+	store.mutex.Unlock()
+
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.Bool("cache.hit", ok),
+	)
+
+	if ok {
+		store.hits.Add(1)
+		span.SetStatus(codes.Ok, "success")
+
+		return row, nil
+	}
+	store.misses.Add(1)
+
+	value, err, shared := store.group.Do(accountNumber, func() (any, error) {
+		store.mutex.Lock()
+		store.fills[accountNumber] = false
+		store.mutex.Unlock()
+
+		fillCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), store.fillTimeout)
+		defer cancel()
+
+		row, err := store.IStore.GetAccountByAccountNumber(postgres_store.WithPrimary(fillCtx), accountNumber)
+
+		store.mutex.Lock()
+		defer store.mutex.Unlock()
+
+		invalidated := store.fills[accountNumber]
+		delete(store.fills, accountNumber)
+
+		if err == nil && !invalidated {
+			if store.cache.add(accountNumber, row, time.Now()) {
+				store.evictions.Add(1)
+			}
+		}
+
+		return row, err
+	})
+
+	span.SetAttributes(attribute.Bool("cache.shared", shared))
+
+	if err != nil {
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return sqlc.GetAccountByAccountNumberRow{}, err
+	}
+
+	span.SetStatus(codes.Ok, "success")
+
+	return value.(sqlc.GetAccountByAccountNumberRow), nil
+}
+
+// WithTx runs the transaction and drops the accounts it declares from the cache
+func (store *Store) WithTx(ctx context.Context, args *postgres_store.WithTxArgs) (*postgres_store.WithTxData, error) {
+	defer store.invalidate(args.Invalidates)
+
+	return store.IStore.WithTx(ctx, args)
+}
+
+// WithTxOptions runs the transaction and drops the accounts it declares from the cache
+func (store *Store) WithTxOptions(ctx context.Context, args *postgres_store.WithTxOptionsArgs) (*postgres_store.WithTxOptionsData, error) {
+	defer store.invalidate(args.Invalidates)
+
+	return store.IStore.WithTxOptions(ctx, args)
+}
+
+// invalidate drops accounts from the cache, also when the transaction failed
+// since its outcome may be unknown
+func (store *Store) invalidate(accountNumbers []string) {
+	if len(accountNumbers) == 0 {
+		return
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	for _, accountNumber := range accountNumbers {
+		store.cache.remove(accountNumber)
+		if _, ok := store.fills[accountNumber]; ok {
+			store.fills[accountNumber] = true
+		}
+	}
+	store.invalidations.Add(int64(len(accountNumbers)))
+}
+
+// Close stops the stats logs, then closes the decorated store
+func (store *Store) Close() {
+	close(store.stop)
+	<-store.done
+
+	store.IStore.Close()
+}
+
+// Stats returns the cache counters
+func (store *Store) Stats() Stats {
+	store.mutex.Lock()
+	entries := store.cache.len()
+	store.mutex.Unlock()
+
+	return Stats{
+		Hits:          store.hits.Load(),
+		Misses:        store.misses.Load(),
+		Evictions:     store.evictions.Load(),
+		Invalidations: store.invalidations.Load(),
+		Entries:       entries,
+	}
+}
+
+// logStats periodically logs the cache counters until the store is closed
+func (store *Store) logStats(interval time.Duration) {
+	const op = "cache_store.Store.logStats"
+
+	defer close(store.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-store.stop:
+			return
+		case <-ticker.C:
+		}
+
+		stats := store.Stats()
+
+		hitRatio := 0.0
+		if lookups := stats.Hits + stats.Misses; lookups > 0 {
+			hitRatio = float64(stats.Hits) / float64(lookups)
+		}
+
+		store.logger.WithFields(logrus.Fields{
+			"[op]":          op,
+			"hits":          stats.Hits,
+			"misses":        stats.Misses,
+			"hit_ratio":     fmt.Sprintf("%.3f", hitRatio),
+			"evictions":     stats.Evictions,
+			"invalidations": stats.Invalidations,
+			"entries":       stats.Entries,
+		}).Info("Account cache stats")
+	}
+}
@@ -0,0 +1,215 @@
+package cache_store
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-core/store/postgres_store"
+	"go-core/store/postgres_store/sqlc"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeStore counts the account queries reaching the decorated store. When
+// release is set, queries wait on it, or on the end of their context, after
+// signaling started.
+type fakeStore struct {
+	postgres_store.IStore
+
+	queries atomic.Int64
+	closed  atomic.Bool
+
+	started chan struct{}
+	release chan struct{}
+	// ctxErr is the error of the query context once it stops waiting
+	ctxErr error
+}
+
+func (fake *fakeStore) GetAccountByAccountNumber(ctx context.Context, accountNumber string) (sqlc.GetAccountByAccountNumberRow, error) {
+	fake.queries.Add(1)
+
+	if fake.release != nil {
+		fake.started <- struct{}{}
+		select {
+		case <-fake.release:
+		case <-ctx.Done():
+		}
+
+		if fake.ctxErr = ctx.Err(); fake.ctxErr != nil {
+			return sqlc.GetAccountByAccountNumberRow{}, fake.ctxErr
+		}
+	}
+
+	return row(accountNumber), nil
+}
+
+func (fake *fakeStore) WithTx(ctx context.Context, args *postgres_store.WithTxArgs) (*postgres_store.WithTxData, error) {
+	return &postgres_store.WithTxData{}, nil
+}
+
+func (fake *fakeStore) Close() {
+	fake.closed.Store(true)
+}
+
+func newTestStore(fake *fakeStore, options Options) *Store {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return NewStore(logger, noop.NewTracerProvider().Tracer("test"), fake, options).(*Store)
+}
+
+func TestStoreStats(t *testing.T) {
+	fake := &fakeStore{}
+	store := newTestStore(fake, Options{MaxEntries: 1})
+	defer store.Close()
+
+	ctx := context.Background()
+	for _, accountNumber := range []string{"A", "A", "B", "A"} {
+		if _, err := store.GetAccountByAccountNumber(ctx, accountNumber); err != nil {
+			t.Fatalf("GetAccountByAccountNumber(%s) error = %v", accountNumber, err)
+		}
+	}
+	store.WithTx(ctx, &postgres_store.WithTxArgs{Invalidates: []string{"A", "B"}})
+
+	want := Stats{Hits: 1, Misses: 3, Evictions: 2, Invalidations: 2, Entries: 0}
+	if got := store.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+	if queries := fake.queries.Load(); queries != 3 {
+		t.Errorf("queries = %d, want 3", queries)
+	}
+}
+
+func TestStoreTTL(t *testing.T) {
+	fake := &fakeStore{}
+	store := newTestStore(fake, Options{TTL: 10 * time.Millisecond})
+	defer store.Close()
+
+	ctx := context.Background()
+	store.GetAccountByAccountNumber(ctx, "A")
+	store.GetAccountByAccountNumber(ctx, "A")
+	time.Sleep(20 * time.Millisecond)
+	store.GetAccountByAccountNumber(ctx, "A")
+
+	if queries := fake.queries.Load(); queries != 2 {
+		t.Errorf("queries = %d, want 2", queries)
+	}
+}
+
+func TestStoreInvalidateDuringFill(t *testing.T) {
+	fake := &fakeStore{started: make(chan struct{}), release: make(chan struct{})}
+	store := newTestStore(fake, Options{})
+	defer store.Close()
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		store.GetAccountByAccountNumber(ctx, "A")
+	}()
+
+	// The row being read may predate the transaction, it must not be cached
+	<-fake.started
+	store.WithTx(ctx, &postgres_store.WithTxArgs{Invalidates: []string{"A"}})
+	close(fake.release)
+	wg.Wait()
+
+	if entries := store.Stats().Entries; entries != 0 {
+		t.Fatalf("Entries = %d after an invalidated fill, want 0", entries)
+	}
+
+	fake.release = nil
+	store.GetAccountByAccountNumber(ctx, "A")
+	store.GetAccountByAccountNumber(ctx, "A")
+
+	if queries := fake.queries.Load(); queries != 2 {
+		t.Errorf("queries = %d, want 2", queries)
+	}
+	if len(store.fills) != 0 {
+		t.Errorf("fills = %v, want none in flight", store.fills)
+	}
+}
+
+func TestStoreFillOutlivesCaller(t *testing.T) {
+	fake := &fakeStore{started: make(chan struct{}), release: make(chan struct{})}
+	store := newTestStore(fake, Options{})
+	defer store.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		store.GetAccountByAccountNumber(ctx, "A")
+	}()
+
+	// Canceling the caller that started the fill must not fail it for the
+	// callers sharing it
+	<-fake.started
+	cancel()
+	close(fake.release)
+	wg.Wait()
+
+	if fake.ctxErr != nil {
+		t.Fatalf("fill context error = %v, want none", fake.ctxErr)
+	}
+	if entries := store.Stats().Entries; entries != 1 {
+		t.Errorf("Entries = %d, want 1", entries)
+	}
+}
+
+func TestStoreFillTimeout(t *testing.T) {
+	fake := &fakeStore{started: make(chan struct{}), release: make(chan struct{})}
+	store := newTestStore(fake, Options{FillTimeout: time.Millisecond})
+	defer store.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := store.GetAccountByAccountNumber(context.Background(), "A")
+		errCh <- err
+	}()
+
+	<-fake.started
+
+	if err := <-errCh; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetAccountByAccountNumber() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestStoreClose(t *testing.T) {
+	tests := []struct {
+		name          string
+		statsInterval time.Duration
+	}{
+		{"without stats", 0},
+		{"with stats", time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &fakeStore{}
+			store := newTestStore(fake, Options{StatsInterval: test.statsInterval})
+			time.Sleep(5 * time.Millisecond)
+
+			store.Close()
+
+			select {
+			case <-store.done:
+			default:
+				t.Fatalf("stats logs still running after Close()")
+			}
+			if !fake.closed.Load() {
+				t.Errorf("Close() did not close the decorated store")
+			}
+		})
+	}
+}
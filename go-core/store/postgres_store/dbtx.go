@@ -0,0 +1,90 @@
+package postgres_store
+
+import (
+	"context"
+
+	"go-core/store/postgres_store/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// translatingDB runs the sqlc queries and passes every error they return
+// through TranslateError
+type translatingDB struct {
+	db sqlc.DBTX
+}
+
+func newTranslatingDB(db sqlc.DBTX) sqlc.DBTX {
+	return &translatingDB{db: db}
+}
+
+func (db *translatingDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	tag, err := db.db.Exec(ctx, sql, args...)
+
+	return tag, TranslateError(err)
+}
+
+func (db *translatingDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	rows, err := db.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	return &translatingRows{Rows: rows}, nil
+}
+
+func (db *translatingDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return &translatingRow{row: db.db.QueryRow(ctx, sql, args...)}
+}
+
+func (db *translatingDB) SendBatch(ctx context.Context, batch *pgx.Batch) pgx.BatchResults {
+	return &translatingBatchResults{results: db.db.SendBatch(ctx, batch)}
+}
+
+type translatingRow struct {
+	row pgx.Row
+}
+
+func (row *translatingRow) Scan(dest ...any) error {
+	return TranslateError(row.row.Scan(dest...))
+}
+
+type translatingRows struct {
+	pgx.Rows
+}
+
+func (rows *translatingRows) Scan(dest ...any) error {
+	return TranslateError(rows.Rows.Scan(dest...))
+}
+
+func (rows *translatingRows) Err() error {
+	return TranslateError(rows.Rows.Err())
+}
+
+type translatingBatchResults struct {
+	results pgx.BatchResults
+}
+
+func (results *translatingBatchResults) Exec() (pgconn.CommandTag, error) {
+	tag, err := results.results.Exec()
+
+	return tag, TranslateError(err)
+}
+
+func (results *translatingBatchResults) Query() (pgx.Rows, error) {
+	rows, err := results.results.Query()
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	return &translatingRows{Rows: rows}, nil
+}
+
+func (results *translatingBatchResults) QueryRow() pgx.Row {
+	return &translatingRow{row: results.results.QueryRow()}
+}
+
+func (results *translatingBatchResults) Close() error {
+	return TranslateError(results.results.Close())
+}
@@ -0,0 +1,103 @@
+package postgres_store
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// PostgreSQL error codes translated to application errors
+const (
+	pgUniqueViolation      = "23505"
+	pgCheckViolation       = "23514"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgQueryCanceled        = "57014"
+	pgTooManyConnections   = "53300"
+)
+
+// TranslateError converts an error of the database driver into an AppError
+// with the matching error code. The original error is kept as the cause, so
+// errors.As still finds a *pgconn.PgError. AppErrors are returned as they are.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.Wrap(apperrors.ErrorCodeNotFound, err, "record not found")
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return translatePgError(pgErr)
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || pgconn.Timeout(err):
+		return apperrors.Wrap(apperrors.ErrorCodeTimeout, err, "database timeout")
+	case errors.Is(err, context.Canceled):
+		return apperrors.Wrap(apperrors.ErrorCodeCanceled, err, "database request canceled")
+	case isConnectionError(err):
+		return apperrors.Wrap(apperrors.ErrorCodeUnavailable, err, "database unavailable")
+	}
+
+	return apperrors.Wrap(apperrors.ErrorCodeInternal, err, "database error")
+}
+
+// translatePgError maps the SQLSTATE of an error reported by PostgreSQL
+func translatePgError(pgErr *pgconn.PgError) error {
+	var appErr *apperrors.AppError
+
+	switch {
+	case pgErr.Code == pgUniqueViolation:
+		appErr = apperrors.Wrap(apperrors.ErrorCodeConflict, pgErr, "duplicate record")
+	case pgErr.Code == pgCheckViolation:
+		appErr = apperrors.Wrap(apperrors.ErrorCodeConstraintViolation, pgErr, "constraint violation")
+	case pgErr.Code == pgSerializationFailure:
+		appErr = apperrors.Wrap(apperrors.ErrorCodeConcurrencyConflict, pgErr, "transaction serialization failure")
+	case pgErr.Code == pgDeadlockDetected:
+		appErr = apperrors.Wrap(apperrors.ErrorCodeConcurrencyConflict, pgErr, "transaction deadlock detected")
+	case pgErr.Code == pgQueryCanceled:
+		appErr = apperrors.Wrap(apperrors.ErrorCodeTimeout, pgErr, "database statement timeout")
+	case pgErr.Code == pgTooManyConnections,
+		strings.HasPrefix(pgErr.Code, "08"),  // connection_exception
+		strings.HasPrefix(pgErr.Code, "57P"): // operator intervention, e.g. admin_shutdown
+		appErr = apperrors.Wrap(apperrors.ErrorCodeUnavailable, pgErr, "database unavailable")
+	default:
+		appErr = apperrors.Wrap(apperrors.ErrorCodeInternal, pgErr, "database error")
+	}
+
+	if pgErr.ConstraintName != "" {
+		return appErr.WithDetailsf("PG Error Code: %s, Constraint: %s", pgErr.Code, pgErr.ConstraintName)
+	}
+
+	return appErr.WithDetailsf("PG Error Code: %s, PG Error Message: %s", pgErr.Code, pgErr.Message)
+}
+
+// isConnectionError reports whether err means the database could not be reached
+func isConnectionError(err error) bool {
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || pgconn.SafeToRetry(err)
+}
@@ -0,0 +1,68 @@
+package postgres_store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestTranslateError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code apperrors.ErrorCode
+	}{
+		{"no rows", pgx.ErrNoRows, apperrors.ErrorCodeNotFound},
+		{"wrapped no rows", fmt.Errorf("query: %w", pgx.ErrNoRows), apperrors.ErrorCodeNotFound},
+		{"unique violation", &pgconn.PgError{Code: pgUniqueViolation}, apperrors.ErrorCodeConflict},
+		{"check violation", &pgconn.PgError{Code: pgCheckViolation}, apperrors.ErrorCodeConstraintViolation},
+		{"serialization failure", &pgconn.PgError{Code: pgSerializationFailure}, apperrors.ErrorCodeConcurrencyConflict},
+		{"deadlock detected", &pgconn.PgError{Code: pgDeadlockDetected}, apperrors.ErrorCodeConcurrencyConflict},
+		{"statement timeout", &pgconn.PgError{Code: pgQueryCanceled}, apperrors.ErrorCodeTimeout},
+		{"too many connections", &pgconn.PgError{Code: pgTooManyConnections}, apperrors.ErrorCodeUnavailable},
+		{"connection failure", &pgconn.PgError{Code: "08006"}, apperrors.ErrorCodeUnavailable},
+		{"admin shutdown", &pgconn.PgError{Code: "57P01"}, apperrors.ErrorCodeUnavailable},
+		{"other sqlstate", &pgconn.PgError{Code: "42P01"}, apperrors.ErrorCodeInternal},
+		{"deadline exceeded", context.DeadlineExceeded, apperrors.ErrorCodeTimeout},
+		{"canceled", context.Canceled, apperrors.ErrorCodeCanceled},
+		{"wrapped canceled", fmt.Errorf("query: %w", context.Canceled), apperrors.ErrorCodeCanceled},
+		{"unexpected eof", io.ErrUnexpectedEOF, apperrors.ErrorCodeUnavailable},
+		{"unknown", errors.New("boom"), apperrors.ErrorCodeInternal},
+		{"app error", apperrors.New(apperrors.ErrorCodeValidation, "invalid"), apperrors.ErrorCodeValidation},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := TranslateError(test.err)
+
+			if !apperrors.IsCode(err, test.code) {
+				t.Fatalf("TranslateError(%v) = %v, want code %s", test.err, err, test.code)
+			}
+			if !errors.Is(err, test.err) {
+				t.Errorf("TranslateError(%v) dropped the cause", test.err)
+			}
+		})
+	}
+}
+
+func TestTranslateErrorNil(t *testing.T) {
+	if err := TranslateError(nil); err != nil {
+		t.Errorf("TranslateError(nil) = %v, want nil", err)
+	}
+}
+
+func TestTranslateErrorKeepsPgError(t *testing.T) {
+	err := TranslateError(&pgconn.PgError{Code: pgUniqueViolation, ConstraintName: "accounts_account_number_key"})
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.ConstraintName != "accounts_account_number_key" {
+		t.Errorf("TranslateError did not keep the *pgconn.PgError, got %v", err)
+	}
+}
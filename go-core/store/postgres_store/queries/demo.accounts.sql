@@ -20,4 +20,101 @@ SELECT
     c.date_of_birth
 FROM demo.accounts a
 INNER JOIN demo.customers c ON a.customer_id = c.customer_id
-WHERE a.account_number = $1;
\ No newline at end of file
+WHERE a.account_number = $1;
+
+-- name: GetAccountsByAccountNumbers :many
+SELECT
+    a.account_id,
+    a.account_number,
+    a.customer_id,
+    a.account_type,
+    a.account_status,
+    a.balance,
+    a.currency,
+    a.opened_date,
+    a.closed_date,
+    a.created_at,
+    a.updated_at,
+    c.customer_number,
+    c.full_name,
+    c.id_number,
+    c.phone_number,
+    c.email,
+    c.address,
+    c.date_of_birth
+FROM demo.accounts a
+INNER JOIN demo.customers c ON a.customer_id = c.customer_id
+WHERE a.account_number = ANY(@account_numbers::varchar[]);
+
+-- name: LockAccountsByAccountNumbers :many
+-- Locks the accounts in account_id order so concurrent transfers between the
+-- same accounts cannot deadlock
+SELECT
+    account_id,
+    account_number,
+    account_status,
+    balance,
+    currency
+FROM demo.accounts
+WHERE account_number = ANY(@account_numbers::varchar[])
+ORDER BY account_id
+FOR UPDATE;
+
+-- name: AddAccountBalance :one
+UPDATE demo.accounts
+SET
+    balance = balance + @amount,
+    updated_at = CURRENT_TIMESTAMP
+WHERE account_id = @account_id
+RETURNING balance;
+
+-- name: GetAccountIDByAccountNumber :one
+SELECT account_id
+FROM demo.accounts
+WHERE account_number = $1;
+
+-- name: GetCustomerIDByCustomerNumber :one
+SELECT customer_id
+FROM demo.customers
+WHERE customer_number = $1;
+
+-- name: CreateAccount :one
+INSERT INTO demo.accounts (
+    account_number,
+    customer_id,
+    account_type,
+    account_status,
+    currency
+) VALUES (
+    @account_number,
+    @customer_id,
+    @account_type,
+    'ACTIVE',
+    @currency
+)
+RETURNING account_id;
+
+-- name: UpdateAccountStatus :exec
+-- Closing an account also sets its closed_date
+UPDATE demo.accounts
+SET account_status = @account_status::varchar,
+    closed_date = CASE WHEN @account_status::varchar = 'CLOSED' THEN CURRENT_DATE ELSE closed_date END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE account_id = @account_id;
+
+-- name: CreateAccountStatusLog :exec
+INSERT INTO demo.account_status_log (
+    account_id,
+    action,
+    status_before,
+    status_after,
+    reason,
+    reference_number
+) VALUES (
+    @account_id,
+    @action,
+    sqlc.narg('status_before'),
+    @status_after,
+    sqlc.narg('reason'),
+    sqlc.narg('reference_number')
+);
@@ -0,0 +1,97 @@
+-- name: CreateTransactionLog :one
+INSERT INTO demo.transaction_log (
+    account_id,
+    transaction_type,
+    amount,
+    balance_before,
+    balance_after,
+    reference_number,
+    description
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING
+    transaction_id,
+    transaction_time;
+
+-- name: GetTransactionLogsByReferenceNumber :many
+SELECT
+    t.transaction_id,
+    t.account_id,
+    a.account_number,
+    t.transaction_type,
+    t.amount,
+    t.balance_before,
+    t.balance_after,
+    t.transaction_time,
+    t.reference_number,
+    t.description
+FROM demo.transaction_log t
+INNER JOIN demo.accounts a ON t.account_id = a.account_id
+WHERE t.reference_number = $1
+  AND t.transaction_type IN ('DEBIT', 'CREDIT')
+ORDER BY t.transaction_id;
+
+-- name: GetTransactionHistory :many
+-- Keyset pagination, newest first: the cursor is the (transaction_time,
+-- transaction_id) of the last row of the previous page
+SELECT
+    transaction_id,
+    transaction_type,
+    amount,
+    balance_before,
+    balance_after,
+    transaction_time,
+    reference_number,
+    description
+FROM demo.transaction_log
+WHERE account_id = @account_id
+  AND (sqlc.narg('transaction_type')::varchar IS NULL OR transaction_type = sqlc.narg('transaction_type')::varchar)
+  AND (sqlc.narg('from_time')::timestamp IS NULL OR transaction_time >= sqlc.narg('from_time')::timestamp)
+  AND (sqlc.narg('to_time')::timestamp IS NULL OR transaction_time < sqlc.narg('to_time')::timestamp)
+  AND (
+      sqlc.narg('cursor_time')::timestamp IS NULL
+      OR (transaction_time, transaction_id) < (sqlc.narg('cursor_time')::timestamp, sqlc.narg('cursor_id')::bigint)
+  )
+ORDER BY transaction_time DESC, transaction_id DESC
+LIMIT @page_size;
+
+-- name: GetStatementBatch :many
+-- Keyset cursor, oldest first: the cursor is the (transaction_time,
+-- transaction_id) of the last row of the previous batch. Rows without a
+-- transaction_time have no place in the statement and would sort last, where
+-- they cannot be used as a cursor, so they are left out.
+SELECT
+    transaction_id,
+    transaction_type,
+    amount,
+    balance_before,
+    balance_after,
+    transaction_time,
+    reference_number,
+    description
+FROM demo.transaction_log
+WHERE account_id = @account_id
+  AND transaction_time IS NOT NULL
+  AND (sqlc.narg('from_time')::timestamp IS NULL OR transaction_time >= sqlc.narg('from_time')::timestamp)
+  AND (sqlc.narg('to_time')::timestamp IS NULL OR transaction_time < sqlc.narg('to_time')::timestamp)
+  AND (
+      sqlc.narg('cursor_time')::timestamp IS NULL
+      OR (transaction_time, transaction_id) > (sqlc.narg('cursor_time')::timestamp, sqlc.narg('cursor_id')::bigint)
+  )
+ORDER BY transaction_time, transaction_id
+LIMIT @batch_size;
+
+-- name: LogBalanceInquiry :exec
+SELECT demo.log_balance_inquiry(
+    @account_id::bigint,
+    sqlc.narg('reference_number')::varchar,
+    sqlc.narg('response_time_ms')::integer
+);
+
+-- name: LogBalanceInquiries :batchexec
+SELECT demo.log_balance_inquiry(
+    @account_id::bigint,
+    sqlc.narg('reference_number')::varchar,
+    sqlc.narg('response_time_ms')::integer
+);
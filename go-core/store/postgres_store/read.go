@@ -0,0 +1,40 @@
+package postgres_store
+
+import (
+	"context"
+
+	"go-core/store/postgres_store/sqlc"
+)
+
+// Read-only queries that tolerate replication lag are routed to the replicas.
+// Queries that must see the latest writes, such as the idempotency lookups of
+// postings, stay on the primary, as do reads under a WithPrimary context.
+
+// primaryKey marks a context whose reads must go to the primary
+type primaryKey struct{}
+
+// WithPrimary returns a context whose reads skip the replicas, for callers
+// that keep what they read, such as a cache filled after an invalidation
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func (store *Store) GetAccountByAccountNumber(ctx context.Context, accountNumber string) (sqlc.GetAccountByAccountNumberRow, error) {
+	return store.reader(ctx).GetAccountByAccountNumber(ctx, accountNumber)
+}
+
+func (store *Store) GetAccountIDByAccountNumber(ctx context.Context, accountNumber string) (int64, error) {
+	return store.reader(ctx).GetAccountIDByAccountNumber(ctx, accountNumber)
+}
+
+func (store *Store) GetTransactionHistory(ctx context.Context, arg sqlc.GetTransactionHistoryParams) ([]sqlc.GetTransactionHistoryRow, error) {
+	return store.reader(ctx).GetTransactionHistory(ctx, arg)
+}
+
+func (store *Store) GetAccountsByAccountNumbers(ctx context.Context, accountNumbers []string) ([]sqlc.GetAccountsByAccountNumbersRow, error) {
+	return store.reader(ctx).GetAccountsByAccountNumbers(ctx, accountNumbers)
+}
+
+func (store *Store) GetStatementBatch(ctx context.Context, arg sqlc.GetStatementBatchParams) ([]sqlc.GetStatementBatchRow, error) {
+	return store.reader(ctx).GetStatementBatch(ctx, arg)
+}
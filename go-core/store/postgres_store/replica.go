@@ -0,0 +1,167 @@
+package postgres_store
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go-core/store/postgres_store/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+)
+
+// replicaLagQuery returns whether a replica is disconnected from the primary
+// and how far it is behind. A replica that replayed everything it received is
+// not lagging, however old the last transaction is, but only while its WAL
+// receiver is streaming: a disconnected replica receives nothing and would
+// otherwise look up to date. Reading the receiver status takes the
+// pg_read_all_stats role. A server that is not in recovery never lags, so the
+// primary itself can stand in for a replica.
+const replicaLagQuery = `
+SELECT
+    pg_is_in_recovery() AND NOT EXISTS (
+        SELECT 1 FROM pg_stat_wal_receiver WHERE status = 'streaming'
+    ) AS disconnected,
+    CASE
+        WHEN NOT pg_is_in_recovery() THEN 0
+        WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+        ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+    END::float8 AS lag_seconds`
+
+// replica is a read-only pool with its last health check result
+type replica struct {
+	name    string
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+
+	healthy atomic.Bool
+}
+
+// replicaSet routes read-only queries round-robin over the healthy replicas
+type replicaSet struct {
+	logger *logrus.Logger
+
+	replicas []*replica
+	next     atomic.Uint64
+
+	healthCheckInterval time.Duration
+	maxLag              time.Duration
+
+	// cancel stops the health checks, done is closed once they stopped
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newReplicaSet(logger *logrus.Logger, pools []*pgxpool.Pool, healthCheckInterval time.Duration, maxLag time.Duration) *replicaSet {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	set := &replicaSet{
+		logger: logger,
+
+		healthCheckInterval: healthCheckInterval,
+		maxLag:              maxLag,
+
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	for _, pool := range pools {
+		set.replicas = append(set.replicas, &replica{
+			name:    fmt.Sprintf("%s:%d", pool.Config().ConnConfig.Host, pool.Config().ConnConfig.Port),
+			pool:    pool,
+			queries: sqlc.New(newTranslatingDB(pool)),
+		})
+	}
+
+	// Replicas take reads once their first check passed
+	go set.checkHealth(ctx)
+
+	return set
+}
+
+// queries returns the queries of the next healthy replica, nil when none is healthy
+func (set *replicaSet) queries() *sqlc.Queries {
+	count := uint64(len(set.replicas))
+	if count == 0 {
+		return nil
+	}
+
+	start := set.next.Add(1)
+	for i := uint64(0); i < count; i++ {
+		replica := set.replicas[(start+i)%count]
+		if replica.healthy.Load() {
+			return replica.queries
+		}
+	}
+
+	return nil
+}
+
+// close stops the health checks and closes the replica pools
+func (set *replicaSet) close() {
+	set.cancel()
+	<-set.done
+
+	for _, replica := range set.replicas {
+		replica.healthy.Store(false)
+		replica.pool.Close()
+	}
+}
+
+// checkHealth checks every replica once per health check interval until ctx
+// is canceled
+func (set *replicaSet) checkHealth(ctx context.Context) {
+	defer close(set.done)
+
+	ticker := time.NewTicker(set.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, replica := range set.replicas {
+			set.check(ctx, replica)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check marks replica healthy when it answers within the health check
+// interval, streams from the primary and lags no more than the maximum lag
+func (set *replicaSet) check(ctx context.Context, replica *replica) {
+	const op = "postgres_store.replicaSet.check"
+
+	ctx, cancel := context.WithTimeout(ctx, set.healthCheckInterval)
+	defer cancel()
+
+	var disconnected bool
+	var lagSeconds float64
+	err := replica.pool.QueryRow(ctx, replicaLagQuery).Scan(&disconnected, &lagSeconds)
+	lag := time.Duration(lagSeconds * float64(time.Second))
+
+	healthy := err == nil && !disconnected && lag <= set.maxLag
+	if replica.healthy.Swap(healthy) == healthy {
+		return
+	}
+
+	logger := set.logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"replica": replica.name,
+		"lag":     lag.String(),
+	})
+	switch {
+	case healthy:
+		logger.Info("Replica takes reads")
+	case err != nil:
+		logger.WithError(TranslateError(err)).Warn("Replica unavailable, reads go to the primary")
+	case disconnected:
+		logger.Warn("Replica not streaming from the primary, reads go to the primary")
+	default:
+		logger.Warnf("Replica lags more than %s, reads go to the primary", set.maxLag)
+	}
+}
@@ -0,0 +1,72 @@
+package postgres_store
+
+import (
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RetryPolicy controls how transactions that fail with a serialization
+// failure or a deadlock are retried
+type RetryPolicy struct {
+	MaxAttempts int           // Attempts including the first one, 1 disables retries
+	BaseDelay   time.Duration // Delay before the first retry, doubled for every further retry
+	MaxDelay    time.Duration // Upper bound of the delay
+	Jitter      float64       // Fraction of the delay that is randomized, up to 1, negative disables jitter
+}
+
+// DefaultRetryPolicy returns the policy used for unset fields
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   10 * time.Millisecond,
+		MaxDelay:    500 * time.Millisecond,
+		Jitter:      0.5,
+	}
+}
+
+// withDefaults fills the unset fields of policy from DefaultRetryPolicy
+func (policy RetryPolicy) withDefaults() RetryPolicy {
+	defaults := DefaultRetryPolicy()
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = defaults.BaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = defaults.MaxDelay
+	}
+	policy.MaxDelay = max(policy.MaxDelay, policy.BaseDelay)
+	if policy.Jitter == 0 {
+		policy.Jitter = defaults.Jitter
+	}
+	policy.Jitter = min(max(policy.Jitter, 0), 1)
+
+	return policy
+}
+
+// delay returns the time to wait before the given retry, starting at 1.
+// Jitter spreads out transactions that conflicted with each other, so they
+// do not collide again on the next attempt.
+func (policy RetryPolicy) delay(retry int) time.Duration {
+	delay := policy.MaxDelay
+	if shift := retry - 1; shift < 32 && policy.BaseDelay<<shift < policy.MaxDelay {
+		delay = policy.BaseDelay << shift
+	}
+
+	return delay - time.Duration(policy.Jitter*rand.Float64()*float64(delay))
+}
+
+// isRetryable reports whether err aborted a transaction that can be run again
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+}
@@ -0,0 +1,109 @@
+package postgres_store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	apperrors "go-core/util/errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestRetryPolicyWithDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		want   RetryPolicy
+	}{
+		{"unset", RetryPolicy{}, DefaultRetryPolicy()},
+		{
+			"set",
+			RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Second, Jitter: 0.2},
+			RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Second, Jitter: 0.2},
+		},
+		{
+			"max delay below base delay",
+			RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second, MaxDelay: time.Millisecond, Jitter: 0.5},
+			RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second, MaxDelay: time.Second, Jitter: 0.5},
+		},
+		{
+			"negative jitter disables jitter",
+			RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second, Jitter: -1},
+			RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second, Jitter: 0},
+		},
+		{
+			"jitter above 1",
+			RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second, Jitter: 2},
+			RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second, Jitter: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.policy.withDefaults(); got != test.want {
+				t.Errorf("withDefaults() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 10 * time.Millisecond, MaxDelay: 100 * time.Millisecond, Jitter: -1}.withDefaults()
+
+	tests := []struct {
+		retry int
+		want  time.Duration
+	}{
+		{1, 10 * time.Millisecond},
+		{2, 20 * time.Millisecond},
+		{3, 40 * time.Millisecond},
+		{4, 80 * time.Millisecond},
+		{5, 100 * time.Millisecond},
+		{40, 100 * time.Millisecond},
+		{100, 100 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		if got := policy.delay(test.retry); got != test.want {
+			t.Errorf("delay(%d) = %s, want %s", test.retry, got, test.want)
+		}
+	}
+}
+
+func TestRetryPolicyDelayJitter(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: 0.5}.withDefaults()
+
+	for i := 0; i < 1000; i++ {
+		if got := policy.delay(1); got <= 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Fatalf("delay(1) = %s, want within (50ms, 100ms]", got)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"serialization failure", &pgconn.PgError{Code: pgSerializationFailure}, true},
+		{"deadlock detected", &pgconn.PgError{Code: pgDeadlockDetected}, true},
+		{"translated serialization failure", TranslateError(&pgconn.PgError{Code: pgSerializationFailure}), true},
+		{"wrapped deadlock", fmt.Errorf("post: %w", &pgconn.PgError{Code: pgDeadlockDetected}), true},
+		{"unique violation", &pgconn.PgError{Code: pgUniqueViolation}, false},
+		{"statement timeout", &pgconn.PgError{Code: pgQueryCanceled}, false},
+		{"app error", apperrors.New(apperrors.ErrorCodeConcurrencyConflict, "conflict"), false},
+		{"other error", errors.New("boom"), false},
+		{"nil", nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isRetryable(test.err); got != test.want {
+				t.Errorf("isRetryable(%v) = %t, want %t", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -41,4 +41,16 @@ CREATE TABLE demo.transaction_log (
     reference_number VARCHAR(50),
     description TEXT,
     response_time_ms INTEGER
+);
+
+-- Account status changes (for audit purposes)
+CREATE TABLE demo.account_status_log (
+    status_log_id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES demo.accounts(account_id),
+    action VARCHAR(20) NOT NULL, -- OPEN, BLOCK, UNBLOCK, CLOSE
+    status_before VARCHAR(20), -- NULL when the account was opened
+    status_after VARCHAR(20) NOT NULL,
+    reason TEXT,
+    reference_number VARCHAR(50),
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
\ No newline at end of file
@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: batch.go
+
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+const logBalanceInquiries = `-- name: LogBalanceInquiries :batchexec
+SELECT demo.log_balance_inquiry(
+    $1::bigint,
+    $2::varchar,
+    $3::integer
+)
+`
+
+type LogBalanceInquiriesBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type LogBalanceInquiriesParams struct {
+	AccountID       int64       `json:"account_id"`
+	ReferenceNumber pgtype.Text `json:"reference_number"`
+	ResponseTimeMs  pgtype.Int4 `json:"response_time_ms"`
+}
+
+func (q *Queries) LogBalanceInquiries(ctx context.Context, arg []LogBalanceInquiriesParams) *LogBalanceInquiriesBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.AccountID,
+			a.ReferenceNumber,
+			a.ResponseTimeMs,
+		}
+		batch.Queue(logBalanceInquiries, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &LogBalanceInquiriesBatchResults{br, len(arg), false}
+}
+
+func (b *LogBalanceInquiriesBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *LogBalanceInquiriesBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
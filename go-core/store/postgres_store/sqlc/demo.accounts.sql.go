@@ -11,6 +11,102 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addAccountBalance = `-- name: AddAccountBalance :one
+UPDATE demo.accounts
+SET
+    balance = balance + $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE account_id = $2
+RETURNING balance
+`
+
+type AddAccountBalanceParams struct {
+	Amount    pgtype.Numeric `json:"amount"`
+	AccountID int64          `json:"account_id"`
+}
+
+func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, addAccountBalance, arg.Amount, arg.AccountID)
+	var balance pgtype.Numeric
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const createAccount = `-- name: CreateAccount :one
+INSERT INTO demo.accounts (
+    account_number,
+    customer_id,
+    account_type,
+    account_status,
+    currency
+) VALUES (
+    $1,
+    $2,
+    $3,
+    'ACTIVE',
+    $4
+)
+RETURNING account_id
+`
+
+type CreateAccountParams struct {
+	AccountNumber string `json:"account_number"`
+	CustomerID    int64  `json:"customer_id"`
+	AccountType   string `json:"account_type"`
+	Currency      string `json:"currency"`
+}
+
+func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.AccountNumber,
+		arg.CustomerID,
+		arg.AccountType,
+		arg.Currency,
+	)
+	var account_id int64
+	err := row.Scan(&account_id)
+	return account_id, err
+}
+
+const createAccountStatusLog = `-- name: CreateAccountStatusLog :exec
+INSERT INTO demo.account_status_log (
+    account_id,
+    action,
+    status_before,
+    status_after,
+    reason,
+    reference_number
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type CreateAccountStatusLogParams struct {
+	AccountID       int64       `json:"account_id"`
+	Action          string      `json:"action"`
+	StatusBefore    pgtype.Text `json:"status_before"`
+	StatusAfter     string      `json:"status_after"`
+	Reason          pgtype.Text `json:"reason"`
+	ReferenceNumber pgtype.Text `json:"reference_number"`
+}
+
+func (q *Queries) CreateAccountStatusLog(ctx context.Context, arg CreateAccountStatusLogParams) error {
+	_, err := q.db.Exec(ctx, createAccountStatusLog,
+		arg.AccountID,
+		arg.Action,
+		arg.StatusBefore,
+		arg.StatusAfter,
+		arg.Reason,
+		arg.ReferenceNumber,
+	)
+	return err
+}
+
 const getAccountByAccountNumber = `-- name: GetAccountByAccountNumber :one
 SELECT
     a.account_id,
@@ -82,3 +178,182 @@ func (q *Queries) GetAccountByAccountNumber(ctx context.Context, accountNumber s
 	)
 	return i, err
 }
+
+const getAccountIDByAccountNumber = `-- name: GetAccountIDByAccountNumber :one
+SELECT account_id
+FROM demo.accounts
+WHERE account_number = $1
+`
+
+func (q *Queries) GetAccountIDByAccountNumber(ctx context.Context, accountNumber string) (int64, error) {
+	row := q.db.QueryRow(ctx, getAccountIDByAccountNumber, accountNumber)
+	var account_id int64
+	err := row.Scan(&account_id)
+	return account_id, err
+}
+
+const getAccountsByAccountNumbers = `-- name: GetAccountsByAccountNumbers :many
+SELECT
+    a.account_id,
+    a.account_number,
+    a.customer_id,
+    a.account_type,
+    a.account_status,
+    a.balance,
+    a.currency,
+    a.opened_date,
+    a.closed_date,
+    a.created_at,
+    a.updated_at,
+    c.customer_number,
+    c.full_name,
+    c.id_number,
+    c.phone_number,
+    c.email,
+    c.address,
+    c.date_of_birth
+FROM demo.accounts a
+INNER JOIN demo.customers c ON a.customer_id = c.customer_id
+WHERE a.account_number = ANY($1::varchar[])
+`
+
+type GetAccountsByAccountNumbersRow struct {
+	AccountID      int64            `json:"account_id"`
+	AccountNumber  string           `json:"account_number"`
+	CustomerID     int64            `json:"customer_id"`
+	AccountType    string           `json:"account_type"`
+	AccountStatus  string           `json:"account_status"`
+	Balance        pgtype.Numeric   `json:"balance"`
+	Currency       string           `json:"currency"`
+	OpenedDate     pgtype.Date      `json:"opened_date"`
+	ClosedDate     pgtype.Date      `json:"closed_date"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	CustomerNumber string           `json:"customer_number"`
+	FullName       string           `json:"full_name"`
+	IDNumber       string           `json:"id_number"`
+	PhoneNumber    pgtype.Text      `json:"phone_number"`
+	Email          pgtype.Text      `json:"email"`
+	Address        pgtype.Text      `json:"address"`
+	DateOfBirth    pgtype.Date      `json:"date_of_birth"`
+}
+
+func (q *Queries) GetAccountsByAccountNumbers(ctx context.Context, accountNumbers []string) ([]GetAccountsByAccountNumbersRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsByAccountNumbers, accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAccountsByAccountNumbersRow{}
+	for rows.Next() {
+		var i GetAccountsByAccountNumbersRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.AccountNumber,
+			&i.CustomerID,
+			&i.AccountType,
+			&i.AccountStatus,
+			&i.Balance,
+			&i.Currency,
+			&i.OpenedDate,
+			&i.ClosedDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CustomerNumber,
+			&i.FullName,
+			&i.IDNumber,
+			&i.PhoneNumber,
+			&i.Email,
+			&i.Address,
+			&i.DateOfBirth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerIDByCustomerNumber = `-- name: GetCustomerIDByCustomerNumber :one
+SELECT customer_id
+FROM demo.customers
+WHERE customer_number = $1
+`
+
+func (q *Queries) GetCustomerIDByCustomerNumber(ctx context.Context, customerNumber string) (int64, error) {
+	row := q.db.QueryRow(ctx, getCustomerIDByCustomerNumber, customerNumber)
+	var customer_id int64
+	err := row.Scan(&customer_id)
+	return customer_id, err
+}
+
+const lockAccountsByAccountNumbers = `-- name: LockAccountsByAccountNumbers :many
+SELECT
+    account_id,
+    account_number,
+    account_status,
+    balance,
+    currency
+FROM demo.accounts
+WHERE account_number = ANY($1::varchar[])
+ORDER BY account_id
+FOR UPDATE
+`
+
+type LockAccountsByAccountNumbersRow struct {
+	AccountID     int64          `json:"account_id"`
+	AccountNumber string         `json:"account_number"`
+	AccountStatus string         `json:"account_status"`
+	Balance       pgtype.Numeric `json:"balance"`
+	Currency      string         `json:"currency"`
+}
+
+// Locks the accounts in account_id order so concurrent transfers between the
+// same accounts cannot deadlock
+func (q *Queries) LockAccountsByAccountNumbers(ctx context.Context, accountNumbers []string) ([]LockAccountsByAccountNumbersRow, error) {
+	rows, err := q.db.Query(ctx, lockAccountsByAccountNumbers, accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LockAccountsByAccountNumbersRow{}
+	for rows.Next() {
+		var i LockAccountsByAccountNumbersRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.AccountNumber,
+			&i.AccountStatus,
+			&i.Balance,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :exec
+UPDATE demo.accounts
+SET account_status = $1::varchar,
+    closed_date = CASE WHEN $1::varchar = 'CLOSED' THEN CURRENT_DATE ELSE closed_date END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE account_id = $2
+`
+
+type UpdateAccountStatusParams struct {
+	AccountStatus string `json:"account_status"`
+	AccountID     int64  `json:"account_id"`
+}
+
+// Closing an account also sets its closed_date
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) error {
+	_, err := q.db.Exec(ctx, updateAccountStatus, arg.AccountStatus, arg.AccountID)
+	return err
+}
@@ -0,0 +1,307 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: demo.transaction_log.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createTransactionLog = `-- name: CreateTransactionLog :one
+INSERT INTO demo.transaction_log (
+    account_id,
+    transaction_type,
+    amount,
+    balance_before,
+    balance_after,
+    reference_number,
+    description
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING
+    transaction_id,
+    transaction_time
+`
+
+type CreateTransactionLogParams struct {
+	AccountID       int64          `json:"account_id"`
+	TransactionType string         `json:"transaction_type"`
+	Amount          pgtype.Numeric `json:"amount"`
+	BalanceBefore   pgtype.Numeric `json:"balance_before"`
+	BalanceAfter    pgtype.Numeric `json:"balance_after"`
+	ReferenceNumber pgtype.Text    `json:"reference_number"`
+	Description     pgtype.Text    `json:"description"`
+}
+
+type CreateTransactionLogRow struct {
+	TransactionID   int64            `json:"transaction_id"`
+	TransactionTime pgtype.Timestamp `json:"transaction_time"`
+}
+
+func (q *Queries) CreateTransactionLog(ctx context.Context, arg CreateTransactionLogParams) (CreateTransactionLogRow, error) {
+	row := q.db.QueryRow(ctx, createTransactionLog,
+		arg.AccountID,
+		arg.TransactionType,
+		arg.Amount,
+		arg.BalanceBefore,
+		arg.BalanceAfter,
+		arg.ReferenceNumber,
+		arg.Description,
+	)
+	var i CreateTransactionLogRow
+	err := row.Scan(&i.TransactionID, &i.TransactionTime)
+	return i, err
+}
+
+const getStatementBatch = `-- name: GetStatementBatch :many
+SELECT
+    transaction_id,
+    transaction_type,
+    amount,
+    balance_before,
+    balance_after,
+    transaction_time,
+    reference_number,
+    description
+FROM demo.transaction_log
+WHERE account_id = $1
+  AND transaction_time IS NOT NULL
+  AND ($2::timestamp IS NULL OR transaction_time >= $2::timestamp)
+  AND ($3::timestamp IS NULL OR transaction_time < $3::timestamp)
+  AND (
+      $4::timestamp IS NULL
+      OR (transaction_time, transaction_id) > ($4::timestamp, $5::bigint)
+  )
+ORDER BY transaction_time, transaction_id
+LIMIT $6
+`
+
+type GetStatementBatchParams struct {
+	AccountID  int64            `json:"account_id"`
+	FromTime   pgtype.Timestamp `json:"from_time"`
+	ToTime     pgtype.Timestamp `json:"to_time"`
+	CursorTime pgtype.Timestamp `json:"cursor_time"`
+	CursorID   pgtype.Int8      `json:"cursor_id"`
+	BatchSize  int32            `json:"batch_size"`
+}
+
+type GetStatementBatchRow struct {
+	TransactionID   int64            `json:"transaction_id"`
+	TransactionType string           `json:"transaction_type"`
+	Amount          pgtype.Numeric   `json:"amount"`
+	BalanceBefore   pgtype.Numeric   `json:"balance_before"`
+	BalanceAfter    pgtype.Numeric   `json:"balance_after"`
+	TransactionTime pgtype.Timestamp `json:"transaction_time"`
+	ReferenceNumber pgtype.Text      `json:"reference_number"`
+	Description     pgtype.Text      `json:"description"`
+}
+
+// Keyset cursor, oldest first: the cursor is the (transaction_time,
+// transaction_id) of the last row of the previous batch. Rows without a
+// transaction_time have no place in the statement and would sort last, where
+// they cannot be used as a cursor, so they are left out.
+func (q *Queries) GetStatementBatch(ctx context.Context, arg GetStatementBatchParams) ([]GetStatementBatchRow, error) {
+	rows, err := q.db.Query(ctx, getStatementBatch,
+		arg.AccountID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetStatementBatchRow{}
+	for rows.Next() {
+		var i GetStatementBatchRow
+		if err := rows.Scan(
+			&i.TransactionID,
+			&i.TransactionType,
+			&i.Amount,
+			&i.BalanceBefore,
+			&i.BalanceAfter,
+			&i.TransactionTime,
+			&i.ReferenceNumber,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransactionHistory = `-- name: GetTransactionHistory :many
+SELECT
+    transaction_id,
+    transaction_type,
+    amount,
+    balance_before,
+    balance_after,
+    transaction_time,
+    reference_number,
+    description
+FROM demo.transaction_log
+WHERE account_id = $1
+  AND ($2::varchar IS NULL OR transaction_type = $2::varchar)
+  AND ($3::timestamp IS NULL OR transaction_time >= $3::timestamp)
+  AND ($4::timestamp IS NULL OR transaction_time < $4::timestamp)
+  AND (
+      $5::timestamp IS NULL
+      OR (transaction_time, transaction_id) < ($5::timestamp, $6::bigint)
+  )
+ORDER BY transaction_time DESC, transaction_id DESC
+LIMIT $7
+`
+
+type GetTransactionHistoryParams struct {
+	AccountID       int64            `json:"account_id"`
+	TransactionType pgtype.Text      `json:"transaction_type"`
+	FromTime        pgtype.Timestamp `json:"from_time"`
+	ToTime          pgtype.Timestamp `json:"to_time"`
+	CursorTime      pgtype.Timestamp `json:"cursor_time"`
+	CursorID        pgtype.Int8      `json:"cursor_id"`
+	PageSize        int32            `json:"page_size"`
+}
+
+type GetTransactionHistoryRow struct {
+	TransactionID   int64            `json:"transaction_id"`
+	TransactionType string           `json:"transaction_type"`
+	Amount          pgtype.Numeric   `json:"amount"`
+	BalanceBefore   pgtype.Numeric   `json:"balance_before"`
+	BalanceAfter    pgtype.Numeric   `json:"balance_after"`
+	TransactionTime pgtype.Timestamp `json:"transaction_time"`
+	ReferenceNumber pgtype.Text      `json:"reference_number"`
+	Description     pgtype.Text      `json:"description"`
+}
+
+// Keyset pagination, newest first: the cursor is the (transaction_time,
+// transaction_id) of the last row of the previous page
+func (q *Queries) GetTransactionHistory(ctx context.Context, arg GetTransactionHistoryParams) ([]GetTransactionHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getTransactionHistory,
+		arg.AccountID,
+		arg.TransactionType,
+		arg.FromTime,
+		arg.ToTime,
+		arg.CursorTime,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTransactionHistoryRow{}
+	for rows.Next() {
+		var i GetTransactionHistoryRow
+		if err := rows.Scan(
+			&i.TransactionID,
+			&i.TransactionType,
+			&i.Amount,
+			&i.BalanceBefore,
+			&i.BalanceAfter,
+			&i.TransactionTime,
+			&i.ReferenceNumber,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransactionLogsByReferenceNumber = `-- name: GetTransactionLogsByReferenceNumber :many
+SELECT
+    t.transaction_id,
+    t.account_id,
+    a.account_number,
+    t.transaction_type,
+    t.amount,
+    t.balance_before,
+    t.balance_after,
+    t.transaction_time,
+    t.reference_number,
+    t.description
+FROM demo.transaction_log t
+INNER JOIN demo.accounts a ON t.account_id = a.account_id
+WHERE t.reference_number = $1
+  AND t.transaction_type IN ('DEBIT', 'CREDIT')
+ORDER BY t.transaction_id
+`
+
+type GetTransactionLogsByReferenceNumberRow struct {
+	TransactionID   int64            `json:"transaction_id"`
+	AccountID       int64            `json:"account_id"`
+	AccountNumber   string           `json:"account_number"`
+	TransactionType string           `json:"transaction_type"`
+	Amount          pgtype.Numeric   `json:"amount"`
+	BalanceBefore   pgtype.Numeric   `json:"balance_before"`
+	BalanceAfter    pgtype.Numeric   `json:"balance_after"`
+	TransactionTime pgtype.Timestamp `json:"transaction_time"`
+	ReferenceNumber pgtype.Text      `json:"reference_number"`
+	Description     pgtype.Text      `json:"description"`
+}
+
+func (q *Queries) GetTransactionLogsByReferenceNumber(ctx context.Context, referenceNumber pgtype.Text) ([]GetTransactionLogsByReferenceNumberRow, error) {
+	rows, err := q.db.Query(ctx, getTransactionLogsByReferenceNumber, referenceNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTransactionLogsByReferenceNumberRow{}
+	for rows.Next() {
+		var i GetTransactionLogsByReferenceNumberRow
+		if err := rows.Scan(
+			&i.TransactionID,
+			&i.AccountID,
+			&i.AccountNumber,
+			&i.TransactionType,
+			&i.Amount,
+			&i.BalanceBefore,
+			&i.BalanceAfter,
+			&i.TransactionTime,
+			&i.ReferenceNumber,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const logBalanceInquiry = `-- name: LogBalanceInquiry :exec
+SELECT demo.log_balance_inquiry(
+    $1::bigint,
+    $2::varchar,
+    $3::integer
+)
+`
+
+type LogBalanceInquiryParams struct {
+	AccountID       int64       `json:"account_id"`
+	ReferenceNumber pgtype.Text `json:"reference_number"`
+	ResponseTimeMs  pgtype.Int4 `json:"response_time_ms"`
+}
+
+func (q *Queries) LogBalanceInquiry(ctx context.Context, arg LogBalanceInquiryParams) error {
+	_, err := q.db.Exec(ctx, logBalanceInquiry, arg.AccountID, arg.ReferenceNumber, arg.ResponseTimeMs)
+	return err
+}
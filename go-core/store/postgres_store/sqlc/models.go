@@ -22,6 +22,17 @@ type DemoAccount struct {
 	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
 }
 
+type DemoAccountStatusLog struct {
+	StatusLogID     int64            `json:"status_log_id"`
+	AccountID       int64            `json:"account_id"`
+	Action          string           `json:"action"`
+	StatusBefore    pgtype.Text      `json:"status_before"`
+	StatusAfter     string           `json:"status_after"`
+	Reason          pgtype.Text      `json:"reason"`
+	ReferenceNumber pgtype.Text      `json:"reference_number"`
+	ChangedAt       pgtype.Timestamp `json:"changed_at"`
+}
+
 type DemoCustomer struct {
 	CustomerID     int64            `json:"customer_id"`
 	CustomerNumber string           `json:"customer_number"`
@@ -6,10 +6,35 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (pgtype.Numeric, error)
+	CreateAccount(ctx context.Context, arg CreateAccountParams) (int64, error)
+	CreateAccountStatusLog(ctx context.Context, arg CreateAccountStatusLogParams) error
+	CreateTransactionLog(ctx context.Context, arg CreateTransactionLogParams) (CreateTransactionLogRow, error)
 	GetAccountByAccountNumber(ctx context.Context, accountNumber string) (GetAccountByAccountNumberRow, error)
+	GetAccountIDByAccountNumber(ctx context.Context, accountNumber string) (int64, error)
+	GetAccountsByAccountNumbers(ctx context.Context, accountNumbers []string) ([]GetAccountsByAccountNumbersRow, error)
+	GetCustomerIDByCustomerNumber(ctx context.Context, customerNumber string) (int64, error)
+	// Keyset cursor, oldest first: the cursor is the (transaction_time,
+	// transaction_id) of the last row of the previous batch. Rows without a
+	// transaction_time have no place in the statement and would sort last, where
+	// they cannot be used as a cursor, so they are left out.
+	GetStatementBatch(ctx context.Context, arg GetStatementBatchParams) ([]GetStatementBatchRow, error)
+	// Keyset pagination, newest first: the cursor is the (transaction_time,
+	// transaction_id) of the last row of the previous page
+	GetTransactionHistory(ctx context.Context, arg GetTransactionHistoryParams) ([]GetTransactionHistoryRow, error)
+	GetTransactionLogsByReferenceNumber(ctx context.Context, referenceNumber pgtype.Text) ([]GetTransactionLogsByReferenceNumberRow, error)
+	// Locks the accounts in account_id order so concurrent transfers between the
+	// same accounts cannot deadlock
+	LockAccountsByAccountNumbers(ctx context.Context, accountNumbers []string) ([]LockAccountsByAccountNumbersRow, error)
+	LogBalanceInquiries(ctx context.Context, arg []LogBalanceInquiriesParams) *LogBalanceInquiriesBatchResults
+	LogBalanceInquiry(ctx context.Context, arg LogBalanceInquiryParams) error
+	// Closing an account also sets its closed_date
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) error
 }
 
 var _ Querier = (*Queries)(nil)
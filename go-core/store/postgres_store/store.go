@@ -2,7 +2,7 @@ package postgres_store
 
 import (
 	"context"
-	"sync"
+	"time"
 
 	"go-core/store/postgres_store/sqlc"
 
@@ -16,6 +16,19 @@ type IStore interface {
 
 	WithTx(ctx context.Context, args *WithTxArgs) (*WithTxData, error)
 	WithTxOptions(ctx context.Context, args *WithTxOptionsArgs) (*WithTxOptionsData, error)
+
+	Close()
+}
+
+// Options configures the store
+type Options struct {
+	RetryPolicy RetryPolicy
+
+	// Replicas take the read-only queries, the primary pool takes them
+	// when no replica is healthy
+	Replicas                   []*pgxpool.Pool
+	ReplicaHealthCheckInterval time.Duration
+	ReplicaMaxLag              time.Duration
 }
 
 type Store struct {
@@ -23,18 +36,58 @@ type Store struct {
 
 	logger *logrus.Logger
 	tracer trace.Tracer
-	mutex  sync.Mutex
 
-	pool *pgxpool.Pool
+	pool        *pgxpool.Pool
+	replicas    *replicaSet
+	retryPolicy RetryPolicy
 }
 
-func NewStore(logger *logrus.Logger, tracer trace.Tracer, pool *pgxpool.Pool) IStore {
-	return &Store{
-		Queries: sqlc.New(pool),
+func NewStore(logger *logrus.Logger, tracer trace.Tracer, pool *pgxpool.Pool, options Options) IStore {
+	store := &Store{
+		Queries: sqlc.New(newTranslatingDB(pool)),
 
 		logger: logger,
 		tracer: tracer,
 
-		pool: pool,
+		pool:        pool,
+		retryPolicy: options.RetryPolicy.withDefaults(),
+	}
+
+	if len(options.Replicas) > 0 {
+		if options.ReplicaHealthCheckInterval <= 0 {
+			options.ReplicaHealthCheckInterval = 5 * time.Second
+		}
+		if options.ReplicaMaxLag <= 0 {
+			options.ReplicaMaxLag = 10 * time.Second
+		}
+
+		store.replicas = newReplicaSet(logger, options.Replicas, options.ReplicaHealthCheckInterval, options.ReplicaMaxLag)
+	}
+
+	return store
+}
+
+// Close stops the replica health checks and closes the replica pools and the
+// primary pool
+func (store *Store) Close() {
+	if store.replicas != nil {
+		store.replicas.close()
 	}
+
+	store.pool.Close()
+}
+
+// reader returns the queries of a healthy replica, or of the primary
+func (store *Store) reader(ctx context.Context) *sqlc.Queries {
+	if primary, _ := ctx.Value(primaryKey{}).(bool); primary {
+		return store.Queries
+	}
+
+	if store.replicas != nil {
+		if queries := store.replicas.queries(); queries != nil {
+			return queries
+		}
+	}
+
+	return store.Queries
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go-core/store/postgres_store/sqlc"
 	apperrors "go-core/util/errors"
@@ -14,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // TxOptions represents available transaction options
@@ -44,15 +46,32 @@ func ReadOnlyTxOptions() TxOptions {
 	}
 }
 
+// ReadCommittedTxOptions returns options for short read-write transactions
+// that do not need serializable isolation
+func ReadCommittedTxOptions() TxOptions {
+	return TxOptions{
+		Isolation:  pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+		Deferrable: false,
+		ReadOnly:   false,
+	}
+}
+
 type WithTxOptionsArgs struct {
 	Opts TxOptions
 	Fn   func(*sqlc.Queries) error
+
+	// Invalidates lists the account numbers Fn may change, for caches in
+	// front of the store
+	Invalidates []string
 }
 
 type WithTxOptionsData struct {
 }
 
-// WithTxOptions executes a function within a database transaction with custom options
+// WithTxOptions executes a function within a database transaction with custom options.
+// Transactions aborted by a serialization failure or a deadlock are run again
+// according to the retry policy of the store, so Fn must be safe to call more than once.
 func (store *Store) WithTxOptions(ctx context.Context, args *WithTxOptionsArgs) (*WithTxOptionsData, error) {
 	const op = "postgres_store.Store.WithTxOptions"
 
@@ -77,141 +96,118 @@ func (store *Store) WithTxOptions(ctx context.Context, args *WithTxOptionsArgs)
 	})
 	logger.Info()
 
-	store.mutex.Lock()
-	defer store.mutex.Unlock()
-
 	deferrable := pgx.NotDeferrable
 	if args.Opts.Deferrable {
 		deferrable = pgx.Deferrable
 	}
 
-	txOptions := &pgx.TxOptions{
+	txOptions := pgx.TxOptions{
 		IsoLevel:       args.Opts.Isolation,
 		AccessMode:     args.Opts.AccessMode,
 		DeferrableMode: deferrable,
 	}
 
-	tx, err := store.pool.BeginTx(ctx, *txOptions)
-	if err != nil {
-		appErr := apperrors.Wrap(apperrors.ErrorCodeUnavailable, err, "failed to begin transaction")
+	// Serialization failures and deadlocks are retried with a backoff
+	retries := 0
+	err := store.runTx(ctx, txOptions, args.Fn)
+	for err != nil && isRetryable(err) && retries+1 < store.retryPolicy.MaxAttempts {
+		retries++
+		delay := store.retryPolicy.delay(retries)
+
+		logger.WithFields(logrus.Fields{
+			"scope": "Retry transaction",
+			"retry": retries,
+			"delay": delay.String(),
+			"err":   err.Error(),
+		}).Warn()
+
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("retry", retries),
+			attribute.String("delay", delay.String()),
+			attribute.String("error", err.Error()),
+		))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = TranslateError(ctx.Err())
+		case <-timer.C:
+			err = store.runTx(ctx, txOptions, args.Fn)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
 
-		logger.WithError(appErr).Error()
+	span.SetAttributes(
+		attribute.Int("tx.retries", retries),
+	)
+
+	if err != nil {
+		logger.WithError(err).Error()
 
 		// Set span attributes and status
-		span.RecordError(appErr)
-		span.SetStatus(codes.Error, appErr.Error())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 
-		return nil, appErr
+		return nil, err
 	}
 
-	q := sqlc.New(tx)
-	err = args.Fn(q)
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.data", fmt.Sprintf("%+v", data)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return data, nil
+}
+
+// runTx runs fn in one database transaction and commits it
+func (store *Store) runTx(ctx context.Context, txOptions pgx.TxOptions, fn func(*sqlc.Queries) error) error {
+	tx, err := store.pool.BeginTx(ctx, txOptions)
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	q := sqlc.New(newTranslatingDB(tx))
+	err = fn(q)
 	if err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
-			if errors.Is(rbErr, pgx.ErrTxClosed) {
-				// Transaction already closed, return original error
-				return nil, err
+			if errors.Is(rbErr, pgx.ErrTxClosed) || ctx.Err() != nil {
+				// Transaction already closed, or its connection was closed
+				// because ctx is done, which rolls it back: return original error
+				return err
 			}
 
 			// Handle PostgreSQL-specific rollback errors
 			var pgErr *pgconn.PgError
 			if errors.As(rbErr, &pgErr) {
 				// PostgreSQL error during rollback - this is a serious server issue
-				appErr := apperrors.Newf(apperrors.ErrorCodeInternal,
+				return apperrors.Newf(apperrors.ErrorCodeInternal,
 					"PG Error Code: %s, PG Error Message: %s, RollbackTx() error, Original error: %s",
 					pgErr.Code, pgErr.Message, err.Error())
-
-				logger.WithError(appErr).Error()
-
-				// Set span attributes and status
-				span.RecordError(appErr)
-				span.SetStatus(codes.Error, appErr.Error())
-
-				return nil, appErr
 			}
 
 			// Generic rollback error
-			appErr := apperrors.Wrapf(apperrors.ErrorCodeInternal, rbErr,
+			return apperrors.Wrapf(apperrors.ErrorCodeInternal, rbErr,
 				"failed to rollback transaction, original error: %s", err.Error())
-
-			logger.WithError(appErr).Error()
-
-			// Set span attributes and status
-			span.RecordError(appErr)
-			span.SetStatus(codes.Error, appErr.Error())
-
-			return nil, appErr
 		}
 
 		// Return the original error from the function (let the caller handle semantic mapping)
-		return nil, err
+		return err
 	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		// Handle commit failures - could be concurrency conflicts or connection issues
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			// Check for concurrency/serialization errors
-			switch pgErr.Code {
-			case "40001": // serialization_failure
-				appErr := apperrors.Wrap(apperrors.ErrorCodeConcurrencyConflict, err, "transaction serialization failure")
-
-				logger.WithError(appErr).Error()
-
-				// Set span attributes and status
-				span.RecordError(appErr)
-				span.SetStatus(codes.Error, appErr.Error())
-
-				return nil, appErr
-			case "40P01": // deadlock_detected
-				appErr := apperrors.Wrap(apperrors.ErrorCodeConcurrencyConflict, err, "transaction deadlock detected")
-
-				logger.WithError(appErr).Error()
-
-				// Set span attributes and status
-				span.RecordError(appErr)
-				span.SetStatus(codes.Error, appErr.Error())
-
-				return nil, appErr
-			default:
-				// Other PostgreSQL errors during commit
-				appErr := apperrors.Wrapf(apperrors.ErrorCodeInternal, err,
-					"PG Error Code: %s, PG Error Message: %s", pgErr.Code, pgErr.Message)
-
-				logger.WithError(appErr).Error()
-
-				// Set span attributes and status
-				span.RecordError(appErr)
-				span.SetStatus(codes.Error, appErr.Error())
-
-				return nil, appErr
-			}
-		}
-
-		// Generic commit failure
-		appErr := apperrors.Wrap(apperrors.ErrorCodeInternal, err, "failed to commit transaction")
-
-		logger.WithError(appErr).Error()
-
-		// Set span attributes and status
-		span.RecordError(appErr)
-		span.SetStatus(codes.Error, appErr.Error())
-
-		return nil, appErr
-	}
-
-	// Set span attributes and status
-	span.SetAttributes(
-		attribute.String("output.data", fmt.Sprintf("%+v", data)),
-	)
-	span.SetStatus(codes.Ok, "success")
-
-	return data, nil
+	// Commit failures are concurrency conflicts or connection issues
+	return TranslateError(tx.Commit(ctx))
 }
 
 type WithTxArgs struct {
 	Fn func(*sqlc.Queries) error
+
+	// Invalidates lists the account numbers Fn may change, for caches in
+	// front of the store
+	Invalidates []string
 }
 
 type WithTxData struct {
@@ -243,8 +239,9 @@ func (store *Store) WithTx(ctx context.Context, args *WithTxArgs) (*WithTxData,
 	logger.Info()
 
 	_, err := store.WithTxOptions(ctx, &WithTxOptionsArgs{
-		Opts: DefaultTxOptions(),
-		Fn:   args.Fn,
+		Opts:        DefaultTxOptions(),
+		Fn:          args.Fn,
+		Invalidates: args.Invalidates,
 	})
 
 	if err != nil {
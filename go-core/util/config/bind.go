@@ -21,6 +21,25 @@ func bindEnvironmentVariables() {
 	viper.BindEnv("store.postgres.pool.retry_max_attempts", "POSTGRES_POOL_RETRY_MAX_ATTEMPTS")
 	viper.BindEnv("store.postgres.pool.retry_base_delay", "POSTGRES_POOL_RETRY_BASE_DELAY")
 	viper.BindEnv("store.postgres.pool.retry_max_delay", "POSTGRES_POOL_RETRY_MAX_DELAY")
+	viper.BindEnv("store.postgres.tx_retry.max_attempts", "POSTGRES_TX_RETRY_MAX_ATTEMPTS")
+	viper.BindEnv("store.postgres.tx_retry.base_delay", "POSTGRES_TX_RETRY_BASE_DELAY")
+	viper.BindEnv("store.postgres.tx_retry.max_delay", "POSTGRES_TX_RETRY_MAX_DELAY")
+	viper.BindEnv("store.postgres.tx_retry.jitter", "POSTGRES_TX_RETRY_JITTER")
+	viper.BindEnv("store.postgres.replicas.connection_strings", "POSTGRES_REPLICA_CONNECTION_STRINGS")
+	viper.BindEnv("store.postgres.replicas.health_check_interval", "POSTGRES_REPLICA_HEALTH_CHECK_INTERVAL")
+	viper.BindEnv("store.postgres.replicas.max_lag", "POSTGRES_REPLICA_MAX_LAG")
+	viper.BindEnv("store.cache.enabled", "STORE_CACHE_ENABLED")
+	viper.BindEnv("store.cache.max_entries", "STORE_CACHE_MAX_ENTRIES")
+	viper.BindEnv("store.cache.ttl", "STORE_CACHE_TTL")
+	viper.BindEnv("store.cache.stats_interval", "STORE_CACHE_STATS_INTERVAL")
+	viper.BindEnv("store.cache.fill_timeout", "STORE_CACHE_FILL_TIMEOUT")
+
+	// Audit config
+
+	viper.BindEnv("audit.inquiry.mode", "AUDIT_INQUIRY_MODE")
+	viper.BindEnv("audit.inquiry.batch_size", "AUDIT_INQUIRY_BATCH_SIZE")
+	viper.BindEnv("audit.inquiry.flush_interval", "AUDIT_INQUIRY_FLUSH_INTERVAL")
+	viper.BindEnv("audit.inquiry.queue_size", "AUDIT_INQUIRY_QUEUE_SIZE")
 
 	// Otel tracer config
 
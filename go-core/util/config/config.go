@@ -8,6 +8,7 @@ import (
 type Config struct {
 	App        App        `mapstructure:"app"`
 	Store      Store      `mapstructure:"store"`
+	Audit      Audit      `mapstructure:"audit"`
 	OtelTracer OtelTracer `mapstructure:"otel_tracer"`
 }
 
@@ -34,13 +35,50 @@ type PostgresPool struct {
 	RetryMaxDelay    time.Duration `mapstructure:"retry_max_delay"`
 }
 
+type PostgresTxRetry struct {
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	BaseDelay   time.Duration `mapstructure:"base_delay"`
+	MaxDelay    time.Duration `mapstructure:"max_delay"`
+	Jitter      float64       `mapstructure:"jitter"`
+}
+
+type PostgresReplicas struct {
+	ConnectionStrings   []string      `mapstructure:"connection_strings"`
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+	MaxLag              time.Duration `mapstructure:"max_lag"`
+}
+
 type Postgres struct {
-	ConnectionString string       `mapstructure:"connection_string"`
-	Pool             PostgresPool `mapstructure:"pool"`
+	ConnectionString string           `mapstructure:"connection_string"`
+	Pool             PostgresPool     `mapstructure:"pool"`
+	TxRetry          PostgresTxRetry  `mapstructure:"tx_retry"`
+	Replicas         PostgresReplicas `mapstructure:"replicas"`
+}
+
+type Cache struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	MaxEntries    int           `mapstructure:"max_entries"`
+	TTL           time.Duration `mapstructure:"ttl"`
+	StatsInterval time.Duration `mapstructure:"stats_interval"`
+	FillTimeout   time.Duration `mapstructure:"fill_timeout"`
 }
 
 type Store struct {
 	Postgres Postgres `mapstructure:"postgres"`
+	Cache    Cache    `mapstructure:"cache"`
+}
+
+// Audit config
+
+type InquiryAudit struct {
+	Mode          string        `mapstructure:"mode"` // off, sync or async
+	BatchSize     int           `mapstructure:"batch_size"`
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	QueueSize     int           `mapstructure:"queue_size"`
+}
+
+type Audit struct {
+	Inquiry InquiryAudit `mapstructure:"inquiry"`
 }
 
 // Otel tracer config
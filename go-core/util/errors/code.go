@@ -20,6 +20,7 @@ const (
 	ErrorCodeConflict     ErrorCode = "CONFLICT"
 	ErrorCodeValidation   ErrorCode = "VALIDATION_FAILED"
 	ErrorCodeRateLimit    ErrorCode = "RATE_LIMIT_EXCEEDED"
+	ErrorCodeCanceled     ErrorCode = "CANCELED" // The caller gave up on the request
 
 	// Server Errors - 5xx category
 	ErrorCodeInternal       ErrorCode = "INTERNAL_ERROR"
@@ -40,6 +41,10 @@ const (
 	ErrorCodeConcurrencyConflict ErrorCode = "CONCURRENCY_CONFLICT"
 )
 
+// statusClientClosedRequest is the non-standard status nginx uses for requests
+// the client canceled
+const statusClientClosedRequest = 499
+
 // ErrorCodeToHTTPStatus maps our error codes to HTTP status codes
 func ErrorCodeToHTTPStatus(code ErrorCode) int {
 	switch code {
@@ -56,6 +61,8 @@ func ErrorCodeToHTTPStatus(code ErrorCode) int {
 		return http.StatusConflict
 	case ErrorCodeRateLimit:
 		return http.StatusTooManyRequests
+	case ErrorCodeCanceled:
+		return statusClientClosedRequest
 
 	// 5xx Server Errors
 	case ErrorCodeInternal, ErrorCodeDataCorrupted:
@@ -91,6 +98,8 @@ func ErrorCodeToGRPCStatus(code ErrorCode, message string) *status.Status {
 		grpcCode = codes.AlreadyExists
 	case ErrorCodeRateLimit:
 		grpcCode = codes.ResourceExhausted
+	case ErrorCodeCanceled:
+		grpcCode = codes.Canceled
 
 	// Server Errors
 	case ErrorCodeInternal, ErrorCodeDataCorrupted:
@@ -127,6 +136,8 @@ func HTTPStatusToErrorCode(httpStatus int) ErrorCode {
 		return ErrorCodeConflict
 	case http.StatusTooManyRequests:
 		return ErrorCodeRateLimit
+	case statusClientClosedRequest:
+		return ErrorCodeCanceled
 
 	// 5xx Server Errors
 	case http.StatusInternalServerError:
@@ -172,6 +183,8 @@ func GRPCStatusToErrorCode(grpcCode codes.Code) ErrorCode {
 		return ErrorCodeConflict
 	case codes.ResourceExhausted:
 		return ErrorCodeRateLimit
+	case codes.Canceled:
+		return ErrorCodeCanceled
 
 	// Server Errors
 	case codes.Internal:
@@ -209,7 +222,8 @@ func (code ErrorCode) IsRetryable() bool {
 		ErrorCodeNotFound, ErrorCodeConflict, ErrorCodeValidation,
 		ErrorCodeInvalidCredentials, ErrorCodeTokenExpired,
 		ErrorCodeTokenInvalid, ErrorCodeInsufficientScope,
-		ErrorCodeConstraintViolation, ErrorCodeConcurrencyConflict:
+		ErrorCodeConstraintViolation, ErrorCodeConcurrencyConflict,
+		ErrorCodeCanceled:
 		return false
 
 	// Server/infrastructure errors - should retry (5xx equivalent)
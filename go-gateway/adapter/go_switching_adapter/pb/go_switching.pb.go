@@ -334,6 +334,384 @@ func (x *CustomerInfo) GetDateOfBirth() string {
 	return ""
 }
 
+// BatchGetAccounts messages
+type BatchGetAccountsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumbers []string               `protobuf:"bytes,1,rep,name=account_numbers,json=accountNumbers,proto3" json:"account_numbers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchGetAccountsRequest) Reset() {
+	*x = BatchGetAccountsRequest{}
+	mi := &file_go_switching_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAccountsRequest) ProtoMessage() {}
+
+func (x *BatchGetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_switching_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_go_switching_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetAccountsRequest) GetAccountNumbers() []string {
+	if x != nil {
+		return x.AccountNumbers
+	}
+	return nil
+}
+
+type BatchGetAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchAccountResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One result per requested account number, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetAccountsResponse) Reset() {
+	*x = BatchGetAccountsResponse{}
+	mi := &file_go_switching_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAccountsResponse) ProtoMessage() {}
+
+func (x *BatchGetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_switching_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_go_switching_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetAccountsResponse) GetResults() []*BatchAccountResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchAccountResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"` // Account and customer are only set when found
+	Account       *AccountInfo           `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Customer      *CustomerInfo          `protobuf:"bytes,4,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAccountResult) Reset() {
+	*x = BatchAccountResult{}
+	mi := &file_go_switching_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAccountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAccountResult) ProtoMessage() {}
+
+func (x *BatchAccountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_go_switching_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAccountResult.ProtoReflect.Descriptor instead.
+func (*BatchAccountResult) Descriptor() ([]byte, []int) {
+	return file_go_switching_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchAccountResult) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *BatchAccountResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *BatchAccountResult) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *BatchAccountResult) GetCustomer() *CustomerInfo {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// StreamStatement messages
+type StreamStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	FromTime      string                 `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`     // Inclusive, RFC 3339 or YYYY-MM-DD
+	ToTime        string                 `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`           // Exclusive RFC 3339, a YYYY-MM-DD date includes the whole day
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // Transactions per message, default 100, at most 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStatementRequest) Reset() {
+	*x = StreamStatementRequest{}
+	mi := &file_go_switching_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatementRequest) ProtoMessage() {}
+
+func (x *StreamStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_switching_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatementRequest.ProtoReflect.Descriptor instead.
+func (*StreamStatementRequest) Descriptor() ([]byte, []int) {
+	return file_go_switching_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamStatementRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *StreamStatementRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *StreamStatementRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+func (x *StreamStatementRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type StreamStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*TransactionInfo     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStatementResponse) Reset() {
+	*x = StreamStatementResponse{}
+	mi := &file_go_switching_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatementResponse) ProtoMessage() {}
+
+func (x *StreamStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_switching_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatementResponse.ProtoReflect.Descriptor instead.
+func (*StreamStatementResponse) Descriptor() ([]byte, []int) {
+	return file_go_switching_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamStatementResponse) GetTransactions() []*TransactionInfo {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type TransactionInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountNumber   string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	TransactionType string                 `protobuf:"bytes,3,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceBefore   string                 `protobuf:"bytes,5,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter    string                 `protobuf:"bytes,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	TransactionTime string                 `protobuf:"bytes,7,opt,name=transaction_time,json=transactionTime,proto3" json:"transaction_time,omitempty"`
+	ReferenceNumber string                 `protobuf:"bytes,8,opt,name=reference_number,json=referenceNumber,proto3" json:"reference_number,omitempty"`
+	Description     string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransactionInfo) Reset() {
+	*x = TransactionInfo{}
+	mi := &file_go_switching_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionInfo) ProtoMessage() {}
+
+func (x *TransactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_go_switching_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionInfo.ProtoReflect.Descriptor instead.
+func (*TransactionInfo) Descriptor() ([]byte, []int) {
+	return file_go_switching_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransactionInfo) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionInfo) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetBalanceBefore() string {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetBalanceAfter() string {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetTransactionTime() string {
+	if x != nil {
+		return x.TransactionTime
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 var File_go_switching_proto protoreflect.FileDescriptor
 
 const file_go_switching_proto_rawDesc = "" +
@@ -370,9 +748,38 @@ const file_go_switching_proto_rawDesc = "" +
 	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x14\n" +
 	"\x05email\x18\x05 \x01(\tR\x05email\x12\x18\n" +
 	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\"\n" +
-	"\rdate_of_birth\x18\a \x01(\tR\vdateOfBirth2\x92\x01\n" +
+	"\rdate_of_birth\x18\a \x01(\tR\vdateOfBirth\"B\n" +
+	"\x17BatchGetAccountsRequest\x12'\n" +
+	"\x0faccount_numbers\x18\x01 \x03(\tR\x0eaccountNumbers\"Y\n" +
+	"\x18BatchGetAccountsResponse\x12=\n" +
+	"\aresults\x18\x01 \x03(\v2#.go_switching_pb.BatchAccountResultR\aresults\"\xc4\x01\n" +
+	"\x12BatchAccountResult\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x126\n" +
+	"\aaccount\x18\x03 \x01(\v2\x1c.go_switching_pb.AccountInfoR\aaccount\x129\n" +
+	"\bcustomer\x18\x04 \x01(\v2\x1d.go_switching_pb.CustomerInfoR\bcustomer\"\x94\x01\n" +
+	"\x16StreamStatementRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1b\n" +
+	"\tfrom_time\x18\x02 \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x03 \x01(\tR\x06toTime\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\"_\n" +
+	"\x17StreamStatementResponse\x12D\n" +
+	"\ftransactions\x18\x01 \x03(\v2 .go_switching_pb.TransactionInfoR\ftransactions\"\xe6\x02\n" +
+	"\x0fTransactionInfo\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12)\n" +
+	"\x10transaction_type\x18\x03 \x01(\tR\x0ftransactionType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12%\n" +
+	"\x0ebalance_before\x18\x05 \x01(\tR\rbalanceBefore\x12#\n" +
+	"\rbalance_after\x18\x06 \x01(\tR\fbalanceAfter\x12)\n" +
+	"\x10transaction_time\x18\a \x01(\tR\x0ftransactionTime\x12)\n" +
+	"\x10reference_number\x18\b \x01(\tR\x0freferenceNumber\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription2\xe3\x02\n" +
 	"\vGoSwitching\x12\x82\x01\n" +
-	"\x19GetAccountByAccountNumber\x121.go_switching_pb.GetAccountByAccountNumberRequest\x1a2.go_switching_pb.GetAccountByAccountNumberResponseB\x16Z\x14./pb;go_switching_pbb\x06proto3"
+	"\x19GetAccountByAccountNumber\x121.go_switching_pb.GetAccountByAccountNumberRequest\x1a2.go_switching_pb.GetAccountByAccountNumberResponse\x12g\n" +
+	"\x10BatchGetAccounts\x12(.go_switching_pb.BatchGetAccountsRequest\x1a).go_switching_pb.BatchGetAccountsResponse\x12f\n" +
+	"\x0fStreamStatement\x12'.go_switching_pb.StreamStatementRequest\x1a(.go_switching_pb.StreamStatementResponse0\x01B\x16Z\x14./pb;go_switching_pbb\x06proto3"
 
 var (
 	file_go_switching_proto_rawDescOnce sync.Once
@@ -386,23 +793,37 @@ func file_go_switching_proto_rawDescGZIP() []byte {
 	return file_go_switching_proto_rawDescData
 }
 
-var file_go_switching_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_go_switching_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_go_switching_proto_goTypes = []any{
 	(*GetAccountByAccountNumberRequest)(nil),  // 0: go_switching_pb.GetAccountByAccountNumberRequest
 	(*GetAccountByAccountNumberResponse)(nil), // 1: go_switching_pb.GetAccountByAccountNumberResponse
 	(*AccountInfo)(nil),                       // 2: go_switching_pb.AccountInfo
 	(*CustomerInfo)(nil),                      // 3: go_switching_pb.CustomerInfo
+	(*BatchGetAccountsRequest)(nil),           // 4: go_switching_pb.BatchGetAccountsRequest
+	(*BatchGetAccountsResponse)(nil),          // 5: go_switching_pb.BatchGetAccountsResponse
+	(*BatchAccountResult)(nil),                // 6: go_switching_pb.BatchAccountResult
+	(*StreamStatementRequest)(nil),            // 7: go_switching_pb.StreamStatementRequest
+	(*StreamStatementResponse)(nil),           // 8: go_switching_pb.StreamStatementResponse
+	(*TransactionInfo)(nil),                   // 9: go_switching_pb.TransactionInfo
 }
 var file_go_switching_proto_depIdxs = []int32{
 	2, // 0: go_switching_pb.GetAccountByAccountNumberResponse.account:type_name -> go_switching_pb.AccountInfo
 	3, // 1: go_switching_pb.GetAccountByAccountNumberResponse.customer:type_name -> go_switching_pb.CustomerInfo
-	0, // 2: go_switching_pb.GoSwitching.GetAccountByAccountNumber:input_type -> go_switching_pb.GetAccountByAccountNumberRequest
-	1, // 3: go_switching_pb.GoSwitching.GetAccountByAccountNumber:output_type -> go_switching_pb.GetAccountByAccountNumberResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 2: go_switching_pb.BatchGetAccountsResponse.results:type_name -> go_switching_pb.BatchAccountResult
+	2, // 3: go_switching_pb.BatchAccountResult.account:type_name -> go_switching_pb.AccountInfo
+	3, // 4: go_switching_pb.BatchAccountResult.customer:type_name -> go_switching_pb.CustomerInfo
+	9, // 5: go_switching_pb.StreamStatementResponse.transactions:type_name -> go_switching_pb.TransactionInfo
+	0, // 6: go_switching_pb.GoSwitching.GetAccountByAccountNumber:input_type -> go_switching_pb.GetAccountByAccountNumberRequest
+	4, // 7: go_switching_pb.GoSwitching.BatchGetAccounts:input_type -> go_switching_pb.BatchGetAccountsRequest
+	7, // 8: go_switching_pb.GoSwitching.StreamStatement:input_type -> go_switching_pb.StreamStatementRequest
+	1, // 9: go_switching_pb.GoSwitching.GetAccountByAccountNumber:output_type -> go_switching_pb.GetAccountByAccountNumberResponse
+	5, // 10: go_switching_pb.GoSwitching.BatchGetAccounts:output_type -> go_switching_pb.BatchGetAccountsResponse
+	8, // 11: go_switching_pb.GoSwitching.StreamStatement:output_type -> go_switching_pb.StreamStatementResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_go_switching_proto_init() }
@@ -416,7 +837,7 @@ func file_go_switching_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_switching_proto_rawDesc), len(file_go_switching_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,136 @@
+package go_switching_adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "go-gateway/adapter/go_switching_adapter/pb"
+	"go-gateway/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// StreamStatementParams defines the input parameters
+type StreamStatementParams struct {
+	AccountNumber string
+	FromTime      string
+	ToTime        string
+	BatchSize     int32
+}
+
+// StreamStatementResult defines the output result
+type StreamStatementResult struct {
+	Transactions int
+	Batches      int
+}
+
+// StreamStatement receives the statement stream and passes each batch to fn.
+// The next batch is only received once fn returned, so a slow caller holds
+// back the stream through flow control.
+func (adapter *Adapter) StreamStatement(ctx context.Context, params *StreamStatementParams, fn func([]Transaction) error) (*StreamStatementResult, error) {
+	const op = "go_switching_adapter.Adapter.StreamStatement"
+
+	// Start span
+	ctx, span := adapter.tracer.Start(ctx, op)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &StreamStatementResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, adapter.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	// Build gRPC request
+	request := &pb.StreamStatementRequest{
+		AccountNumber: params.AccountNumber,
+		FromTime:      params.FromTime,
+		ToTime:        params.ToTime,
+		BatchSize:     params.BatchSize,
+	}
+
+	// The stream is canceled when this call returns early
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Call external service
+	err := func() error {
+		stream, err := adapter.goSwitchingClient.StreamStatement(ctx, request)
+		if err != nil {
+			return fmt.Errorf("error sending request: %w", err)
+		}
+
+		for {
+			response, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("error receiving response: %w", err)
+			}
+
+			// Map pb response to domain models
+			transactions := make([]Transaction, 0, len(response.Transactions))
+			for _, transaction := range response.Transactions {
+				transactions = append(transactions, toTransaction(transaction))
+			}
+
+			if err := fn(transactions); err != nil {
+				return err
+			}
+
+			result.Transactions += len(transactions)
+			result.Batches++
+		}
+	}()
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope":        "Stream statement",
+			"transactions": result.Transactions,
+			"err":          err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.Int("output.transactions", result.Transactions),
+		attribute.Int("output.batches", result.Batches),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
+
+// toTransaction maps pb transaction info to the transaction model
+func toTransaction(transaction *pb.TransactionInfo) Transaction {
+	return Transaction{
+		TransactionID:   transaction.GetTransactionId(),
+		AccountNumber:   transaction.GetAccountNumber(),
+		TransactionType: transaction.GetTransactionType(),
+		Amount:          transaction.GetAmount(),
+		BalanceBefore:   transaction.GetBalanceBefore(),
+		BalanceAfter:    transaction.GetBalanceAfter(),
+		TransactionTime: transaction.GetTransactionTime(),
+		ReferenceNumber: transaction.GetReferenceNumber(),
+		Description:     transaction.GetDescription(),
+	}
+}
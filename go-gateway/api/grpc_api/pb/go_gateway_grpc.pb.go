@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	GoGateway_GetAccountByAccountNumber_FullMethodName = "/go_gateway_pb.GoGateway/GetAccountByAccountNumber"
+	GoGateway_BatchGetAccounts_FullMethodName          = "/go_gateway_pb.GoGateway/BatchGetAccounts"
+	GoGateway_StreamStatement_FullMethodName           = "/go_gateway_pb.GoGateway/StreamStatement"
 )
 
 // GoGatewayClient is the client API for GoGateway service.
@@ -29,6 +31,8 @@ const (
 // Go Gateway service definition
 type GoGatewayClient interface {
 	GetAccountByAccountNumber(ctx context.Context, in *GetAccountByAccountNumberRequest, opts ...grpc.CallOption) (*GetAccountByAccountNumberResponse, error)
+	BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error)
+	StreamStatement(ctx context.Context, in *StreamStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamStatementResponse], error)
 }
 
 type goGatewayClient struct {
@@ -49,6 +53,35 @@ func (c *goGatewayClient) GetAccountByAccountNumber(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *goGatewayClient) BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetAccountsResponse)
+	err := c.cc.Invoke(ctx, GoGateway_BatchGetAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goGatewayClient) StreamStatement(ctx context.Context, in *StreamStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamStatementResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoGateway_ServiceDesc.Streams[0], GoGateway_StreamStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamStatementRequest, StreamStatementResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoGateway_StreamStatementClient = grpc.ServerStreamingClient[StreamStatementResponse]
+
 // GoGatewayServer is the server API for GoGateway service.
 // All implementations must embed UnimplementedGoGatewayServer
 // for forward compatibility.
@@ -56,6 +89,8 @@ func (c *goGatewayClient) GetAccountByAccountNumber(ctx context.Context, in *Get
 // Go Gateway service definition
 type GoGatewayServer interface {
 	GetAccountByAccountNumber(context.Context, *GetAccountByAccountNumberRequest) (*GetAccountByAccountNumberResponse, error)
+	BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error)
+	StreamStatement(*StreamStatementRequest, grpc.ServerStreamingServer[StreamStatementResponse]) error
 	mustEmbedUnimplementedGoGatewayServer()
 }
 
@@ -69,6 +104,12 @@ type UnimplementedGoGatewayServer struct{}
 func (UnimplementedGoGatewayServer) GetAccountByAccountNumber(context.Context, *GetAccountByAccountNumberRequest) (*GetAccountByAccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByAccountNumber not implemented")
 }
+func (UnimplementedGoGatewayServer) BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAccounts not implemented")
+}
+func (UnimplementedGoGatewayServer) StreamStatement(*StreamStatementRequest, grpc.ServerStreamingServer[StreamStatementResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStatement not implemented")
+}
 func (UnimplementedGoGatewayServer) mustEmbedUnimplementedGoGatewayServer() {}
 func (UnimplementedGoGatewayServer) testEmbeddedByValue()                   {}
 
@@ -108,6 +149,35 @@ func _GoGateway_GetAccountByAccountNumber_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoGateway_BatchGetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoGatewayServer).BatchGetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoGateway_BatchGetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoGatewayServer).BatchGetAccounts(ctx, req.(*BatchGetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoGateway_StreamStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoGatewayServer).StreamStatement(m, &grpc.GenericServerStream[StreamStatementRequest, StreamStatementResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoGateway_StreamStatementServer = grpc.ServerStreamingServer[StreamStatementResponse]
+
 // GoGateway_ServiceDesc is the grpc.ServiceDesc for GoGateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,7 +189,17 @@ var GoGateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountByAccountNumber",
 			Handler:    _GoGateway_GetAccountByAccountNumber_Handler,
 		},
+		{
+			MethodName: "BatchGetAccounts",
+			Handler:    _GoGateway_BatchGetAccounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStatement",
+			Handler:       _GoGateway_StreamStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "go_gateway.proto",
 }
@@ -36,6 +36,7 @@ func (api *Api) SetupRoutes(app *fiber.App) *fiber.App {
 	// Account Routes
 	account := app.Group("/accounts")
 	account.Get("/", api.GetAccountByAccountNumber)
+	account.Post("/batch", api.BatchGetAccounts)
 
 	return app
 }
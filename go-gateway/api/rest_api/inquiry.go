@@ -61,3 +61,66 @@ func (api *Api) GetAccountByAccountNumber(c *fiber.Ctx) error {
 
 	return c.JSON(result)
 }
+
+// BatchGetAccountsRequest is the body of a batch inquiry
+type BatchGetAccountsRequest struct {
+	AccountNumbers []string `json:"account_numbers"`
+}
+
+func (api *Api) BatchGetAccounts(c *fiber.Ctx) error {
+	const op = "rest_api.Api.BatchGetAccounts"
+
+	// Start span
+	ctx, span := api.tracer.Start(c.Context(), op)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("api.endpoint", "/accounts/batch"),
+		attribute.String("api.method", "POST"),
+	)
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+
+	var request BatchGetAccountsRequest
+	if err := c.BodyParser(&request); err != nil {
+		// Record the error in the span
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body: " + err.Error(),
+		})
+	}
+
+	// Simulate a validation operation
+	time.Sleep(250 * time.Millisecond)
+
+	params := &service.BatchGetAccountsParams{
+		AccountNumbers: request.AccountNumbers,
+	}
+
+	logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	}).Info()
+
+	result, err := api.service.BatchGetAccounts(ctx, params)
+	if err != nil {
+		// Record the error in the span
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// Record success attributes
+	span.SetAttributes(
+		attribute.Int("api.output.results", len(result.Results)),
+	)
+	span.SetStatus(codes.Ok, "request completed successfully")
+
+	return c.JSON(result)
+}
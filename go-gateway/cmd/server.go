@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip" // Accept gzip compressed requests
 	"google.golang.org/grpc/reflection"
 )
 
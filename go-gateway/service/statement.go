@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go-gateway/adapter/go_switching_adapter"
+	"go-gateway/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type StreamStatementParams struct {
+	AccountNumber string
+	FromTime      string
+	ToTime        string
+	BatchSize     int32
+}
+
+type StreamStatementResult struct {
+	Transactions int
+	Batches      int
+}
+
+// StreamStatement proxies the statement stream of go-switching, passing each batch to send
+func (service *Service) StreamStatement(ctx context.Context, params *StreamStatementParams, send func([]Transaction) error) (*StreamStatementResult, error) {
+	const op = "service.Service.StreamStatement"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	// Call adapter, mapping every batch to service domain models
+	adapterResult, err := service.goSwitchingAdapter.StreamStatement(ctx, &go_switching_adapter.StreamStatementParams{
+		AccountNumber: params.AccountNumber,
+		FromTime:      params.FromTime,
+		ToTime:        params.ToTime,
+		BatchSize:     params.BatchSize,
+	}, func(batch []go_switching_adapter.Transaction) error {
+		transactions := make([]Transaction, 0, len(batch))
+		for _, transaction := range batch {
+			transactions = append(transactions, Transaction(transaction))
+		}
+
+		return send(transactions)
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Call go-switching adapter",
+			"err":   err.Error(),
+		}).Error()
+
+		// Record the error in the span
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	result := &StreamStatementResult{
+		Transactions: adapterResult.Transactions,
+		Batches:      adapterResult.Batches,
+	}
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.Int("output.transactions", result.Transactions),
+		attribute.Int("output.batches", result.Batches),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
@@ -130,7 +130,7 @@ func IsClientError(err error) bool {
 		ErrorCodeNotFound, ErrorCodeConflict, ErrorCodeValidation,
 		ErrorCodeRateLimit, ErrorCodeInvalidCredentials, ErrorCodeTokenExpired,
 		ErrorCodeTokenInvalid, ErrorCodeInsufficientScope, ErrorCodeConstraintViolation,
-		ErrorCodeConcurrencyConflict:
+		ErrorCodeConcurrencyConflict, ErrorCodeCanceled:
 		return true
 
 	default:
@@ -67,35 +67,121 @@ func (adapter *Adapter) GetAccountByAccountNumber(ctx context.Context, params *G
 	}
 
 	// Map pb response to domain models
-	result.Account = Account{
-		AccountID:     response.Account.AccountId,
-		AccountNumber: response.Account.AccountNumber,
-		CustomerID:    response.Account.CustomerId,
-		AccountType:   response.Account.AccountType,
-		AccountStatus: response.Account.AccountStatus,
-		Balance:       response.Account.Balance,
-		Currency:      response.Account.Currency,
-		OpenedDate:    response.Account.OpenedDate,
-		ClosedDate:    response.Account.ClosedDate,
-		CreatedAt:     response.Account.CreatedAt,
-		UpdatedAt:     response.Account.UpdatedAt,
+	result.Account = toAccount(response.Account)
+	result.Customer = toCustomer(response.Customer)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
+
+// BatchGetAccountsParams defines the input parameters
+type BatchGetAccountsParams struct {
+	AccountNumbers []string
+}
+
+// BatchGetAccountsResult defines the output result
+type BatchGetAccountsResult struct {
+	Results []AccountResult
+}
+
+func (adapter *Adapter) BatchGetAccounts(ctx context.Context, params *BatchGetAccountsParams) (*BatchGetAccountsResult, error) {
+	const op = "go_core_adapter.Adapter.BatchGetAccounts"
+
+	// Start span
+	ctx, span := adapter.tracer.Start(ctx, op)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &BatchGetAccountsResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, adapter.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	// Build gRPC request
+	request := &pb.BatchGetAccountsRequest{
+		AccountNumbers: params.AccountNumbers,
+	}
+
+	// Call external service
+	response, err := adapter.goCoreClient.BatchGetAccounts(ctx, request)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Batch get accounts",
+			"err":   err.Error(),
+		}).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
-	result.Customer = Customer{
-		CustomerNumber: response.Customer.CustomerNumber,
-		FullName:       response.Customer.FullName,
-		IDNumber:       response.Customer.IdNumber,
-		PhoneNumber:    response.Customer.PhoneNumber,
-		Email:          response.Customer.Email,
-		Address:        response.Customer.Address,
-		DateOfBirth:    response.Customer.DateOfBirth,
+	// Map pb response to domain models
+	result.Results = make([]AccountResult, 0, len(response.Results))
+	for _, item := range response.Results {
+		accountResult := AccountResult{
+			AccountNumber: item.AccountNumber,
+			Found:         item.Found,
+		}
+		if item.Found {
+			accountResult.Account = toAccount(item.Account)
+			accountResult.Customer = toCustomer(item.Customer)
+		}
+
+		result.Results = append(result.Results, accountResult)
 	}
 
 	// Set span attributes and status
 	span.SetAttributes(
-		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+		attribute.Int("output.results", len(result.Results)),
 	)
 	span.SetStatus(codes.Ok, "success")
 
 	return result, nil
 }
+
+// toAccount maps pb account info to the account model
+func toAccount(account *pb.AccountInfo) Account {
+	return Account{
+		AccountID:     account.GetAccountId(),
+		AccountNumber: account.GetAccountNumber(),
+		CustomerID:    account.GetCustomerId(),
+		AccountType:   account.GetAccountType(),
+		AccountStatus: account.GetAccountStatus(),
+		Balance:       account.GetBalance(),
+		Currency:      account.GetCurrency(),
+		OpenedDate:    account.GetOpenedDate(),
+		ClosedDate:    account.GetClosedDate(),
+		CreatedAt:     account.GetCreatedAt(),
+		UpdatedAt:     account.GetUpdatedAt(),
+	}
+}
+
+// toCustomer maps pb customer info to the customer model
+func toCustomer(customer *pb.CustomerInfo) Customer {
+	return Customer{
+		CustomerNumber: customer.GetCustomerNumber(),
+		FullName:       customer.GetFullName(),
+		IDNumber:       customer.GetIdNumber(),
+		PhoneNumber:    customer.GetPhoneNumber(),
+		Email:          customer.GetEmail(),
+		Address:        customer.GetAddress(),
+		DateOfBirth:    customer.GetDateOfBirth(),
+	}
+}
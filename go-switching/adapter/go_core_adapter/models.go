@@ -25,3 +25,25 @@ type Customer struct {
 	Address        string
 	DateOfBirth    string
 }
+
+// AccountResult represents one account of a batch inquiry, Account and
+// Customer are only set when Found
+type AccountResult struct {
+	AccountNumber string
+	Found         bool
+	Account       Account
+	Customer      Customer
+}
+
+// Transaction represents a transaction log entry from go-core service
+type Transaction struct {
+	TransactionID   int64
+	AccountNumber   string
+	TransactionType string
+	Amount          string
+	BalanceBefore   string
+	BalanceAfter    string
+	TransactionTime string
+	ReferenceNumber string
+	Description     string
+}
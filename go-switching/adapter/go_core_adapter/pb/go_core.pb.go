@@ -23,10 +23,11 @@ const (
 
 // GetAccountByAccountNumber messages
 type GetAccountByAccountNumberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber   string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	ReferenceNumber string                 `protobuf:"bytes,2,opt,name=reference_number,json=referenceNumber,proto3" json:"reference_number,omitempty"` // Recorded by the inquiry audit, the trace ID is used if empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetAccountByAccountNumberRequest) Reset() {
@@ -66,6 +67,13 @@ func (x *GetAccountByAccountNumberRequest) GetAccountNumber() string {
 	return ""
 }
 
+func (x *GetAccountByAccountNumberRequest) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
 type GetAccountByAccountNumberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Account       *AccountInfo           `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -334,46 +342,984 @@ func (x *CustomerInfo) GetDateOfBirth() string {
 	return ""
 }
 
-var File_go_core_proto protoreflect.FileDescriptor
+// BatchGetAccounts messages
+type BatchGetAccountsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumbers []string               `protobuf:"bytes,1,rep,name=account_numbers,json=accountNumbers,proto3" json:"account_numbers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_go_core_proto_rawDesc = "" +
-	"\n" +
-	"\rgo_core.proto\x12\n" +
-	"go_core_pb\"I\n" +
-	" GetAccountByAccountNumberRequest\x12%\n" +
-	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\"\x8c\x01\n" +
-	"!GetAccountByAccountNumberResponse\x121\n" +
-	"\aaccount\x18\x01 \x01(\v2\x17.go_core_pb.AccountInfoR\aaccount\x124\n" +
-	"\bcustomer\x18\x02 \x01(\v2\x18.go_core_pb.CustomerInfoR\bcustomer\"\xf4\x02\n" +
-	"\vAccountInfo\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
-	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1f\n" +
-	"\vcustomer_id\x18\x03 \x01(\x03R\n" +
-	"customerId\x12!\n" +
-	"\faccount_type\x18\x04 \x01(\tR\vaccountType\x12%\n" +
-	"\x0eaccount_status\x18\x05 \x01(\tR\raccountStatus\x12\x18\n" +
-	"\abalance\x18\x06 \x01(\tR\abalance\x12\x1a\n" +
-	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1f\n" +
-	"\vopened_date\x18\b \x01(\tR\n" +
-	"openedDate\x12\x1f\n" +
-	"\vclosed_date\x18\t \x01(\tR\n" +
-	"closedDate\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\n" +
-	" \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xe8\x01\n" +
-	"\fCustomerInfo\x12'\n" +
-	"\x0fcustomer_number\x18\x01 \x01(\tR\x0ecustomerNumber\x12\x1b\n" +
-	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x1b\n" +
-	"\tid_number\x18\x03 \x01(\tR\bidNumber\x12!\n" +
-	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x14\n" +
-	"\x05email\x18\x05 \x01(\tR\x05email\x12\x18\n" +
-	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\"\n" +
-	"\rdate_of_birth\x18\a \x01(\tR\vdateOfBirth2\x82\x01\n" +
+func (x *BatchGetAccountsRequest) Reset() {
+	*x = BatchGetAccountsRequest{}
+	mi := &file_go_core_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAccountsRequest) ProtoMessage() {}
+
+func (x *BatchGetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetAccountsRequest) GetAccountNumbers() []string {
+	if x != nil {
+		return x.AccountNumbers
+	}
+	return nil
+}
+
+type BatchGetAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchAccountResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One result per requested account number, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetAccountsResponse) Reset() {
+	*x = BatchGetAccountsResponse{}
+	mi := &file_go_core_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAccountsResponse) ProtoMessage() {}
+
+func (x *BatchGetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetAccountsResponse) GetResults() []*BatchAccountResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchAccountResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"` // Account and customer are only set when found
+	Account       *AccountInfo           `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Customer      *CustomerInfo          `protobuf:"bytes,4,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAccountResult) Reset() {
+	*x = BatchAccountResult{}
+	mi := &file_go_core_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAccountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAccountResult) ProtoMessage() {}
+
+func (x *BatchAccountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAccountResult.ProtoReflect.Descriptor instead.
+func (*BatchAccountResult) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchAccountResult) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *BatchAccountResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *BatchAccountResult) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *BatchAccountResult) GetCustomer() *CustomerInfo {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// Debit messages
+type DebitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber   string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount          string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Decimal with at most 2 fraction digits, e.g. "150000.00"
+	ReferenceNumber string                 `protobuf:"bytes,3,opt,name=reference_number,json=referenceNumber,proto3" json:"reference_number,omitempty"` // Idempotency key, a repeated request returns the original transaction
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DebitRequest) Reset() {
+	*x = DebitRequest{}
+	mi := &file_go_core_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitRequest) ProtoMessage() {}
+
+func (x *DebitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitRequest.ProtoReflect.Descriptor instead.
+func (*DebitRequest) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DebitRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *DebitRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DebitRequest) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *DebitRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DebitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *TransactionInfo       `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebitResponse) Reset() {
+	*x = DebitResponse{}
+	mi := &file_go_core_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebitResponse) ProtoMessage() {}
+
+func (x *DebitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebitResponse.ProtoReflect.Descriptor instead.
+func (*DebitResponse) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DebitResponse) GetTransaction() *TransactionInfo {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// Credit messages
+type CreditRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber   string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount          string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReferenceNumber string                 `protobuf:"bytes,3,opt,name=reference_number,json=referenceNumber,proto3" json:"reference_number,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreditRequest) Reset() {
+	*x = CreditRequest{}
+	mi := &file_go_core_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditRequest) ProtoMessage() {}
+
+func (x *CreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
+func (*CreditRequest) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreditRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *TransactionInfo       `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditResponse) Reset() {
+	*x = CreditResponse{}
+	mi := &file_go_core_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditResponse) ProtoMessage() {}
+
+func (x *CreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditResponse.ProtoReflect.Descriptor instead.
+func (*CreditResponse) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreditResponse) GetTransaction() *TransactionInfo {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// Transfer messages
+type TransferRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountNumber string                 `protobuf:"bytes,1,opt,name=from_account_number,json=fromAccountNumber,proto3" json:"from_account_number,omitempty"`
+	ToAccountNumber   string                 `protobuf:"bytes,2,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
+	Amount            string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReferenceNumber   string                 `protobuf:"bytes,4,opt,name=reference_number,json=referenceNumber,proto3" json:"reference_number,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_go_core_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransferRequest) GetFromAccountNumber() string {
+	if x != nil {
+		return x.FromAccountNumber
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetToAccountNumber() string {
+	if x != nil {
+		return x.ToAccountNumber
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type TransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debit         *TransactionInfo       `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        *TransactionInfo       `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_go_core_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransferResponse) GetDebit() *TransactionInfo {
+	if x != nil {
+		return x.Debit
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetCredit() *TransactionInfo {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+type TransactionInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountNumber   string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	TransactionType string                 `protobuf:"bytes,3,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceBefore   string                 `protobuf:"bytes,5,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter    string                 `protobuf:"bytes,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	TransactionTime string                 `protobuf:"bytes,7,opt,name=transaction_time,json=transactionTime,proto3" json:"transaction_time,omitempty"`
+	ReferenceNumber string                 `protobuf:"bytes,8,opt,name=reference_number,json=referenceNumber,proto3" json:"reference_number,omitempty"`
+	Description     string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransactionInfo) Reset() {
+	*x = TransactionInfo{}
+	mi := &file_go_core_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionInfo) ProtoMessage() {}
+
+func (x *TransactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionInfo.ProtoReflect.Descriptor instead.
+func (*TransactionInfo) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransactionInfo) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionInfo) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetBalanceBefore() string {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetBalanceAfter() string {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetTransactionTime() string {
+	if x != nil {
+		return x.TransactionTime
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// GetTransactionHistory messages
+type GetTransactionHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber   string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	FromTime        string                 `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`                      // Inclusive, RFC 3339 or YYYY-MM-DD
+	ToTime          string                 `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`                            // Exclusive RFC 3339, a YYYY-MM-DD date includes the whole day
+	TransactionType string                 `protobuf:"bytes,4,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"` // e.g. DEBIT, all types if empty
+	PageSize        int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // Defaults to 50, at most 500
+	PageToken       string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // next_page_token of the previous page
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryRequest) Reset() {
+	*x = GetTransactionHistoryRequest{}
+	mi := &file_go_core_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryRequest) ProtoMessage() {}
+
+func (x *GetTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTransactionHistoryRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTransactionHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetTransactionHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*TransactionInfo     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`                          // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryResponse) Reset() {
+	*x = GetTransactionHistoryResponse{}
+	mi := &file_go_core_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryResponse) ProtoMessage() {}
+
+func (x *GetTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTransactionHistoryResponse) GetTransactions() []*TransactionInfo {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// StreamStatement messages
+type StreamStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	FromTime      string                 `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`     // Inclusive, RFC 3339 or YYYY-MM-DD
+	ToTime        string                 `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`           // Exclusive RFC 3339, a YYYY-MM-DD date includes the whole day
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // Transactions per message, default 100, at most 1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStatementRequest) Reset() {
+	*x = StreamStatementRequest{}
+	mi := &file_go_core_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatementRequest) ProtoMessage() {}
+
+func (x *StreamStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatementRequest.ProtoReflect.Descriptor instead.
+func (*StreamStatementRequest) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StreamStatementRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *StreamStatementRequest) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *StreamStatementRequest) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+func (x *StreamStatementRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type StreamStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*TransactionInfo     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStatementResponse) Reset() {
+	*x = StreamStatementResponse{}
+	mi := &file_go_core_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatementResponse) ProtoMessage() {}
+
+func (x *StreamStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_core_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatementResponse.ProtoReflect.Descriptor instead.
+func (*StreamStatementResponse) Descriptor() ([]byte, []int) {
+	return file_go_core_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StreamStatementResponse) GetTransactions() []*TransactionInfo {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+var File_go_core_proto protoreflect.FileDescriptor
+
+const file_go_core_proto_rawDesc = "" +
+	"\n" +
+	"\rgo_core.proto\x12\n" +
+	"go_core_pb\"t\n" +
+	" GetAccountByAccountNumberRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12)\n" +
+	"\x10reference_number\x18\x02 \x01(\tR\x0freferenceNumber\"\x8c\x01\n" +
+	"!GetAccountByAccountNumberResponse\x121\n" +
+	"\aaccount\x18\x01 \x01(\v2\x17.go_core_pb.AccountInfoR\aaccount\x124\n" +
+	"\bcustomer\x18\x02 \x01(\v2\x18.go_core_pb.CustomerInfoR\bcustomer\"\xf4\x02\n" +
+	"\vAccountInfo\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\x03R\n" +
+	"customerId\x12!\n" +
+	"\faccount_type\x18\x04 \x01(\tR\vaccountType\x12%\n" +
+	"\x0eaccount_status\x18\x05 \x01(\tR\raccountStatus\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vopened_date\x18\b \x01(\tR\n" +
+	"openedDate\x12\x1f\n" +
+	"\vclosed_date\x18\t \x01(\tR\n" +
+	"closedDate\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xe8\x01\n" +
+	"\fCustomerInfo\x12'\n" +
+	"\x0fcustomer_number\x18\x01 \x01(\tR\x0ecustomerNumber\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x1b\n" +
+	"\tid_number\x18\x03 \x01(\tR\bidNumber\x12!\n" +
+	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\"\n" +
+	"\rdate_of_birth\x18\a \x01(\tR\vdateOfBirth\"B\n" +
+	"\x17BatchGetAccountsRequest\x12'\n" +
+	"\x0faccount_numbers\x18\x01 \x03(\tR\x0eaccountNumbers\"T\n" +
+	"\x18BatchGetAccountsResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.go_core_pb.BatchAccountResultR\aresults\"\xba\x01\n" +
+	"\x12BatchAccountResult\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x121\n" +
+	"\aaccount\x18\x03 \x01(\v2\x17.go_core_pb.AccountInfoR\aaccount\x124\n" +
+	"\bcustomer\x18\x04 \x01(\v2\x18.go_core_pb.CustomerInfoR\bcustomer\"\x9a\x01\n" +
+	"\fDebitRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12)\n" +
+	"\x10reference_number\x18\x03 \x01(\tR\x0freferenceNumber\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"N\n" +
+	"\rDebitResponse\x12=\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1b.go_core_pb.TransactionInfoR\vtransaction\"\x9b\x01\n" +
+	"\rCreditRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12)\n" +
+	"\x10reference_number\x18\x03 \x01(\tR\x0freferenceNumber\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"O\n" +
+	"\x0eCreditResponse\x12=\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1b.go_core_pb.TransactionInfoR\vtransaction\"\xd2\x01\n" +
+	"\x0fTransferRequest\x12.\n" +
+	"\x13from_account_number\x18\x01 \x01(\tR\x11fromAccountNumber\x12*\n" +
+	"\x11to_account_number\x18\x02 \x01(\tR\x0ftoAccountNumber\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12)\n" +
+	"\x10reference_number\x18\x04 \x01(\tR\x0freferenceNumber\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"z\n" +
+	"\x10TransferResponse\x121\n" +
+	"\x05debit\x18\x01 \x01(\v2\x1b.go_core_pb.TransactionInfoR\x05debit\x123\n" +
+	"\x06credit\x18\x02 \x01(\v2\x1b.go_core_pb.TransactionInfoR\x06credit\"\xe6\x02\n" +
+	"\x0fTransactionInfo\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12)\n" +
+	"\x10transaction_type\x18\x03 \x01(\tR\x0ftransactionType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12%\n" +
+	"\x0ebalance_before\x18\x05 \x01(\tR\rbalanceBefore\x12#\n" +
+	"\rbalance_after\x18\x06 \x01(\tR\fbalanceAfter\x12)\n" +
+	"\x10transaction_time\x18\a \x01(\tR\x0ftransactionTime\x12)\n" +
+	"\x10reference_number\x18\b \x01(\tR\x0freferenceNumber\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\"\xe2\x01\n" +
+	"\x1cGetTransactionHistoryRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1b\n" +
+	"\tfrom_time\x18\x02 \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x03 \x01(\tR\x06toTime\x12)\n" +
+	"\x10transaction_type\x18\x04 \x01(\tR\x0ftransactionType\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x88\x01\n" +
+	"\x1dGetTransactionHistoryResponse\x12?\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1b.go_core_pb.TransactionInfoR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x94\x01\n" +
+	"\x16StreamStatementRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1b\n" +
+	"\tfrom_time\x18\x02 \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x03 \x01(\tR\x06toTime\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\"Z\n" +
+	"\x17StreamStatementResponse\x12?\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1b.go_core_pb.TransactionInfoR\ftransactions2\xf3\x04\n" +
 	"\x06GoCore\x12x\n" +
-	"\x19GetAccountByAccountNumber\x12,.go_core_pb.GetAccountByAccountNumberRequest\x1a-.go_core_pb.GetAccountByAccountNumberResponseB\x11Z\x0f./pb;go_core_pbb\x06proto3"
+	"\x19GetAccountByAccountNumber\x12,.go_core_pb.GetAccountByAccountNumberRequest\x1a-.go_core_pb.GetAccountByAccountNumberResponse\x12]\n" +
+	"\x10BatchGetAccounts\x12#.go_core_pb.BatchGetAccountsRequest\x1a$.go_core_pb.BatchGetAccountsResponse\x12<\n" +
+	"\x05Debit\x12\x18.go_core_pb.DebitRequest\x1a\x19.go_core_pb.DebitResponse\x12?\n" +
+	"\x06Credit\x12\x19.go_core_pb.CreditRequest\x1a\x1a.go_core_pb.CreditResponse\x12E\n" +
+	"\bTransfer\x12\x1b.go_core_pb.TransferRequest\x1a\x1c.go_core_pb.TransferResponse\x12l\n" +
+	"\x15GetTransactionHistory\x12(.go_core_pb.GetTransactionHistoryRequest\x1a).go_core_pb.GetTransactionHistoryResponse\x12\\\n" +
+	"\x0fStreamStatement\x12\".go_core_pb.StreamStatementRequest\x1a#.go_core_pb.StreamStatementResponse0\x01B\x11Z\x0f./pb;go_core_pbb\x06proto3"
 
 var (
 	file_go_core_proto_rawDescOnce sync.Once
@@ -387,23 +1333,58 @@ func file_go_core_proto_rawDescGZIP() []byte {
 	return file_go_core_proto_rawDescData
 }
 
-var file_go_core_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_go_core_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_go_core_proto_goTypes = []any{
 	(*GetAccountByAccountNumberRequest)(nil),  // 0: go_core_pb.GetAccountByAccountNumberRequest
 	(*GetAccountByAccountNumberResponse)(nil), // 1: go_core_pb.GetAccountByAccountNumberResponse
 	(*AccountInfo)(nil),                       // 2: go_core_pb.AccountInfo
 	(*CustomerInfo)(nil),                      // 3: go_core_pb.CustomerInfo
+	(*BatchGetAccountsRequest)(nil),           // 4: go_core_pb.BatchGetAccountsRequest
+	(*BatchGetAccountsResponse)(nil),          // 5: go_core_pb.BatchGetAccountsResponse
+	(*BatchAccountResult)(nil),                // 6: go_core_pb.BatchAccountResult
+	(*DebitRequest)(nil),                      // 7: go_core_pb.DebitRequest
+	(*DebitResponse)(nil),                     // 8: go_core_pb.DebitResponse
+	(*CreditRequest)(nil),                     // 9: go_core_pb.CreditRequest
+	(*CreditResponse)(nil),                    // 10: go_core_pb.CreditResponse
+	(*TransferRequest)(nil),                   // 11: go_core_pb.TransferRequest
+	(*TransferResponse)(nil),                  // 12: go_core_pb.TransferResponse
+	(*TransactionInfo)(nil),                   // 13: go_core_pb.TransactionInfo
+	(*GetTransactionHistoryRequest)(nil),      // 14: go_core_pb.GetTransactionHistoryRequest
+	(*GetTransactionHistoryResponse)(nil),     // 15: go_core_pb.GetTransactionHistoryResponse
+	(*StreamStatementRequest)(nil),            // 16: go_core_pb.StreamStatementRequest
+	(*StreamStatementResponse)(nil),           // 17: go_core_pb.StreamStatementResponse
 }
 var file_go_core_proto_depIdxs = []int32{
-	2, // 0: go_core_pb.GetAccountByAccountNumberResponse.account:type_name -> go_core_pb.AccountInfo
-	3, // 1: go_core_pb.GetAccountByAccountNumberResponse.customer:type_name -> go_core_pb.CustomerInfo
-	0, // 2: go_core_pb.GoCore.GetAccountByAccountNumber:input_type -> go_core_pb.GetAccountByAccountNumberRequest
-	1, // 3: go_core_pb.GoCore.GetAccountByAccountNumber:output_type -> go_core_pb.GetAccountByAccountNumberResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: go_core_pb.GetAccountByAccountNumberResponse.account:type_name -> go_core_pb.AccountInfo
+	3,  // 1: go_core_pb.GetAccountByAccountNumberResponse.customer:type_name -> go_core_pb.CustomerInfo
+	6,  // 2: go_core_pb.BatchGetAccountsResponse.results:type_name -> go_core_pb.BatchAccountResult
+	2,  // 3: go_core_pb.BatchAccountResult.account:type_name -> go_core_pb.AccountInfo
+	3,  // 4: go_core_pb.BatchAccountResult.customer:type_name -> go_core_pb.CustomerInfo
+	13, // 5: go_core_pb.DebitResponse.transaction:type_name -> go_core_pb.TransactionInfo
+	13, // 6: go_core_pb.CreditResponse.transaction:type_name -> go_core_pb.TransactionInfo
+	13, // 7: go_core_pb.TransferResponse.debit:type_name -> go_core_pb.TransactionInfo
+	13, // 8: go_core_pb.TransferResponse.credit:type_name -> go_core_pb.TransactionInfo
+	13, // 9: go_core_pb.GetTransactionHistoryResponse.transactions:type_name -> go_core_pb.TransactionInfo
+	13, // 10: go_core_pb.StreamStatementResponse.transactions:type_name -> go_core_pb.TransactionInfo
+	0,  // 11: go_core_pb.GoCore.GetAccountByAccountNumber:input_type -> go_core_pb.GetAccountByAccountNumberRequest
+	4,  // 12: go_core_pb.GoCore.BatchGetAccounts:input_type -> go_core_pb.BatchGetAccountsRequest
+	7,  // 13: go_core_pb.GoCore.Debit:input_type -> go_core_pb.DebitRequest
+	9,  // 14: go_core_pb.GoCore.Credit:input_type -> go_core_pb.CreditRequest
+	11, // 15: go_core_pb.GoCore.Transfer:input_type -> go_core_pb.TransferRequest
+	14, // 16: go_core_pb.GoCore.GetTransactionHistory:input_type -> go_core_pb.GetTransactionHistoryRequest
+	16, // 17: go_core_pb.GoCore.StreamStatement:input_type -> go_core_pb.StreamStatementRequest
+	1,  // 18: go_core_pb.GoCore.GetAccountByAccountNumber:output_type -> go_core_pb.GetAccountByAccountNumberResponse
+	5,  // 19: go_core_pb.GoCore.BatchGetAccounts:output_type -> go_core_pb.BatchGetAccountsResponse
+	8,  // 20: go_core_pb.GoCore.Debit:output_type -> go_core_pb.DebitResponse
+	10, // 21: go_core_pb.GoCore.Credit:output_type -> go_core_pb.CreditResponse
+	12, // 22: go_core_pb.GoCore.Transfer:output_type -> go_core_pb.TransferResponse
+	15, // 23: go_core_pb.GoCore.GetTransactionHistory:output_type -> go_core_pb.GetTransactionHistoryResponse
+	17, // 24: go_core_pb.GoCore.StreamStatement:output_type -> go_core_pb.StreamStatementResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_go_core_proto_init() }
@@ -417,7 +1398,7 @@ func file_go_core_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_core_proto_rawDesc), len(file_go_core_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,12 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	GoCore_GetAccountByAccountNumber_FullMethodName = "/go_core_pb.GoCore/GetAccountByAccountNumber"
+	GoCore_BatchGetAccounts_FullMethodName          = "/go_core_pb.GoCore/BatchGetAccounts"
+	GoCore_Debit_FullMethodName                     = "/go_core_pb.GoCore/Debit"
+	GoCore_Credit_FullMethodName                    = "/go_core_pb.GoCore/Credit"
+	GoCore_Transfer_FullMethodName                  = "/go_core_pb.GoCore/Transfer"
+	GoCore_GetTransactionHistory_FullMethodName     = "/go_core_pb.GoCore/GetTransactionHistory"
+	GoCore_StreamStatement_FullMethodName           = "/go_core_pb.GoCore/StreamStatement"
 )
 
 // GoCoreClient is the client API for GoCore service.
@@ -29,6 +35,12 @@ const (
 // Go Core service definition
 type GoCoreClient interface {
 	GetAccountByAccountNumber(ctx context.Context, in *GetAccountByAccountNumberRequest, opts ...grpc.CallOption) (*GetAccountByAccountNumberResponse, error)
+	BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error)
+	Debit(ctx context.Context, in *DebitRequest, opts ...grpc.CallOption) (*DebitResponse, error)
+	Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error)
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	StreamStatement(ctx context.Context, in *StreamStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamStatementResponse], error)
 }
 
 type goCoreClient struct {
@@ -49,6 +61,75 @@ func (c *goCoreClient) GetAccountByAccountNumber(ctx context.Context, in *GetAcc
 	return out, nil
 }
 
+func (c *goCoreClient) BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetAccountsResponse)
+	err := c.cc.Invoke(ctx, GoCore_BatchGetAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCoreClient) Debit(ctx context.Context, in *DebitRequest, opts ...grpc.CallOption) (*DebitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebitResponse)
+	err := c.cc.Invoke(ctx, GoCore_Debit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCoreClient) Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditResponse)
+	err := c.cc.Invoke(ctx, GoCore_Credit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCoreClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, GoCore_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCoreClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, GoCore_GetTransactionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCoreClient) StreamStatement(ctx context.Context, in *StreamStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamStatementResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCore_ServiceDesc.Streams[0], GoCore_StreamStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamStatementRequest, StreamStatementResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCore_StreamStatementClient = grpc.ServerStreamingClient[StreamStatementResponse]
+
 // GoCoreServer is the server API for GoCore service.
 // All implementations must embed UnimplementedGoCoreServer
 // for forward compatibility.
@@ -56,6 +137,12 @@ func (c *goCoreClient) GetAccountByAccountNumber(ctx context.Context, in *GetAcc
 // Go Core service definition
 type GoCoreServer interface {
 	GetAccountByAccountNumber(context.Context, *GetAccountByAccountNumberRequest) (*GetAccountByAccountNumberResponse, error)
+	BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error)
+	Debit(context.Context, *DebitRequest) (*DebitResponse, error)
+	Credit(context.Context, *CreditRequest) (*CreditResponse, error)
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	StreamStatement(*StreamStatementRequest, grpc.ServerStreamingServer[StreamStatementResponse]) error
 	mustEmbedUnimplementedGoCoreServer()
 }
 
@@ -69,6 +156,24 @@ type UnimplementedGoCoreServer struct{}
 func (UnimplementedGoCoreServer) GetAccountByAccountNumber(context.Context, *GetAccountByAccountNumberRequest) (*GetAccountByAccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByAccountNumber not implemented")
 }
+func (UnimplementedGoCoreServer) BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAccounts not implemented")
+}
+func (UnimplementedGoCoreServer) Debit(context.Context, *DebitRequest) (*DebitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Debit not implemented")
+}
+func (UnimplementedGoCoreServer) Credit(context.Context, *CreditRequest) (*CreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Credit not implemented")
+}
+func (UnimplementedGoCoreServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedGoCoreServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedGoCoreServer) StreamStatement(*StreamStatementRequest, grpc.ServerStreamingServer[StreamStatementResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStatement not implemented")
+}
 func (UnimplementedGoCoreServer) mustEmbedUnimplementedGoCoreServer() {}
 func (UnimplementedGoCoreServer) testEmbeddedByValue()                {}
 
@@ -108,6 +213,107 @@ func _GoCore_GetAccountByAccountNumber_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCore_BatchGetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCoreServer).BatchGetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCore_BatchGetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCoreServer).BatchGetAccounts(ctx, req.(*BatchGetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCore_Debit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCoreServer).Debit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCore_Debit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCoreServer).Debit(ctx, req.(*DebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCore_Credit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCoreServer).Credit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCore_Credit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCoreServer).Credit(ctx, req.(*CreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCore_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCoreServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCore_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCoreServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCore_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCoreServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCore_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCoreServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCore_StreamStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCoreServer).StreamStatement(m, &grpc.GenericServerStream[StreamStatementRequest, StreamStatementResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCore_StreamStatementServer = grpc.ServerStreamingServer[StreamStatementResponse]
+
 // GoCore_ServiceDesc is the grpc.ServiceDesc for GoCore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,7 +325,33 @@ var GoCore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountByAccountNumber",
 			Handler:    _GoCore_GetAccountByAccountNumber_Handler,
 		},
+		{
+			MethodName: "BatchGetAccounts",
+			Handler:    _GoCore_BatchGetAccounts_Handler,
+		},
+		{
+			MethodName: "Debit",
+			Handler:    _GoCore_Debit_Handler,
+		},
+		{
+			MethodName: "Credit",
+			Handler:    _GoCore_Credit_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _GoCore_Transfer_Handler,
+		},
+		{
+			MethodName: "GetTransactionHistory",
+			Handler:    _GoCore_GetTransactionHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStatement",
+			Handler:       _GoCore_StreamStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "go_core.proto",
 }
@@ -52,35 +52,107 @@ func (api *Api) GetAccountByAccountNumber(ctx context.Context, request *pb.GetAc
 	}
 
 	// Map domain models to protobuf response
-	response.Account = &pb.AccountInfo{
-		AccountId:     result.Account.AccountID,
-		AccountNumber: result.Account.AccountNumber,
-		CustomerId:    result.Account.CustomerID,
-		AccountType:   result.Account.AccountType,
-		AccountStatus: result.Account.AccountStatus,
-		Balance:       result.Account.Balance,
-		Currency:      result.Account.Currency,
-		OpenedDate:    result.Account.OpenedDate,
-		ClosedDate:    result.Account.ClosedDate,
-		CreatedAt:     result.Account.CreatedAt,
-		UpdatedAt:     result.Account.UpdatedAt,
+	response.Account = toAccountInfo(&result.Account)
+	response.Customer = toCustomerInfo(&result.Customer)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return response, nil
+}
+
+func (api *Api) BatchGetAccounts(ctx context.Context, request *pb.BatchGetAccountsRequest) (*pb.BatchGetAccountsResponse, error) {
+	const op = "grpc_api.Api.BatchGetAccounts"
+
+	// Start span
+	ctx, span := api.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Initialize response
+	response := &pb.BatchGetAccountsResponse{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	result, err := api.service.BatchGetAccounts(ctx, &service.BatchGetAccountsParams{
+		AccountNumbers: request.AccountNumbers,
+	})
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, apperrors.ToGRPCError(err)
 	}
 
-	response.Customer = &pb.CustomerInfo{
-		CustomerNumber: result.Customer.CustomerNumber,
-		FullName:       result.Customer.FullName,
-		IdNumber:       result.Customer.IDNumber,
-		PhoneNumber:    result.Customer.PhoneNumber,
-		Email:          result.Customer.Email,
-		Address:        result.Customer.Address,
-		DateOfBirth:    result.Customer.DateOfBirth,
+	// Map domain models to protobuf response
+	response.Results = make([]*pb.BatchAccountResult, 0, len(result.Results))
+	for i := range result.Results {
+		accountResult := &result.Results[i]
+
+		item := &pb.BatchAccountResult{
+			AccountNumber: accountResult.AccountNumber,
+			Found:         accountResult.Found,
+		}
+		if accountResult.Found {
+			item.Account = toAccountInfo(&accountResult.Account)
+			item.Customer = toCustomerInfo(&accountResult.Customer)
+		}
+
+		response.Results = append(response.Results, item)
 	}
 
 	// Set span attributes and status
 	span.SetAttributes(
-		attribute.String("output.response", fmt.Sprintf("%+v", response)),
+		attribute.Int("output.results", len(response.Results)),
 	)
 	span.SetStatus(codes.Ok, "success")
 
 	return response, nil
 }
+
+// toAccountInfo maps an account domain model to protobuf
+func toAccountInfo(account *service.Account) *pb.AccountInfo {
+	return &pb.AccountInfo{
+		AccountId:     account.AccountID,
+		AccountNumber: account.AccountNumber,
+		CustomerId:    account.CustomerID,
+		AccountType:   account.AccountType,
+		AccountStatus: account.AccountStatus,
+		Balance:       account.Balance,
+		Currency:      account.Currency,
+		OpenedDate:    account.OpenedDate,
+		ClosedDate:    account.ClosedDate,
+		CreatedAt:     account.CreatedAt,
+		UpdatedAt:     account.UpdatedAt,
+	}
+}
+
+// toCustomerInfo maps a customer domain model to protobuf
+func toCustomerInfo(customer *service.Customer) *pb.CustomerInfo {
+	return &pb.CustomerInfo{
+		CustomerNumber: customer.CustomerNumber,
+		FullName:       customer.FullName,
+		IdNumber:       customer.IDNumber,
+		PhoneNumber:    customer.PhoneNumber,
+		Email:          customer.Email,
+		Address:        customer.Address,
+		DateOfBirth:    customer.DateOfBirth,
+	}
+}
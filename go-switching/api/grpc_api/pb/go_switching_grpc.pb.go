@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	GoSwitching_GetAccountByAccountNumber_FullMethodName = "/go_switching_pb.GoSwitching/GetAccountByAccountNumber"
+	GoSwitching_BatchGetAccounts_FullMethodName          = "/go_switching_pb.GoSwitching/BatchGetAccounts"
+	GoSwitching_StreamStatement_FullMethodName           = "/go_switching_pb.GoSwitching/StreamStatement"
 )
 
 // GoSwitchingClient is the client API for GoSwitching service.
@@ -29,6 +31,8 @@ const (
 // Go Switching service definition
 type GoSwitchingClient interface {
 	GetAccountByAccountNumber(ctx context.Context, in *GetAccountByAccountNumberRequest, opts ...grpc.CallOption) (*GetAccountByAccountNumberResponse, error)
+	BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error)
+	StreamStatement(ctx context.Context, in *StreamStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamStatementResponse], error)
 }
 
 type goSwitchingClient struct {
@@ -49,6 +53,35 @@ func (c *goSwitchingClient) GetAccountByAccountNumber(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *goSwitchingClient) BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetAccountsResponse)
+	err := c.cc.Invoke(ctx, GoSwitching_BatchGetAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goSwitchingClient) StreamStatement(ctx context.Context, in *StreamStatementRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamStatementResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoSwitching_ServiceDesc.Streams[0], GoSwitching_StreamStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamStatementRequest, StreamStatementResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoSwitching_StreamStatementClient = grpc.ServerStreamingClient[StreamStatementResponse]
+
 // GoSwitchingServer is the server API for GoSwitching service.
 // All implementations must embed UnimplementedGoSwitchingServer
 // for forward compatibility.
@@ -56,6 +89,8 @@ func (c *goSwitchingClient) GetAccountByAccountNumber(ctx context.Context, in *G
 // Go Switching service definition
 type GoSwitchingServer interface {
 	GetAccountByAccountNumber(context.Context, *GetAccountByAccountNumberRequest) (*GetAccountByAccountNumberResponse, error)
+	BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error)
+	StreamStatement(*StreamStatementRequest, grpc.ServerStreamingServer[StreamStatementResponse]) error
 	mustEmbedUnimplementedGoSwitchingServer()
 }
 
@@ -69,6 +104,12 @@ type UnimplementedGoSwitchingServer struct{}
 func (UnimplementedGoSwitchingServer) GetAccountByAccountNumber(context.Context, *GetAccountByAccountNumberRequest) (*GetAccountByAccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByAccountNumber not implemented")
 }
+func (UnimplementedGoSwitchingServer) BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAccounts not implemented")
+}
+func (UnimplementedGoSwitchingServer) StreamStatement(*StreamStatementRequest, grpc.ServerStreamingServer[StreamStatementResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStatement not implemented")
+}
 func (UnimplementedGoSwitchingServer) mustEmbedUnimplementedGoSwitchingServer() {}
 func (UnimplementedGoSwitchingServer) testEmbeddedByValue()                     {}
 
@@ -108,6 +149,35 @@ func _GoSwitching_GetAccountByAccountNumber_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoSwitching_BatchGetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoSwitchingServer).BatchGetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoSwitching_BatchGetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoSwitchingServer).BatchGetAccounts(ctx, req.(*BatchGetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoSwitching_StreamStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoSwitchingServer).StreamStatement(m, &grpc.GenericServerStream[StreamStatementRequest, StreamStatementResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoSwitching_StreamStatementServer = grpc.ServerStreamingServer[StreamStatementResponse]
+
 // GoSwitching_ServiceDesc is the grpc.ServiceDesc for GoSwitching service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,7 +189,17 @@ var GoSwitching_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountByAccountNumber",
 			Handler:    _GoSwitching_GetAccountByAccountNumber_Handler,
 		},
+		{
+			MethodName: "BatchGetAccounts",
+			Handler:    _GoSwitching_BatchGetAccounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStatement",
+			Handler:       _GoSwitching_StreamStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "go_switching.proto",
 }
@@ -0,0 +1,89 @@
+package grpc_api
+
+import (
+	"fmt"
+
+	pb "go-switching/api/grpc_api/pb"
+	"go-switching/service"
+	apperrors "go-switching/util/errors"
+	"go-switching/util/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (api *Api) StreamStatement(request *pb.StreamStatementRequest, stream pb.GoSwitching_StreamStatementServer) error {
+	const op = "grpc_api.Api.StreamStatement"
+
+	// Start span
+	ctx, span := api.tracer.Start(stream.Context(), op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.request", fmt.Sprintf("%+v", request)),
+	)
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, api.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":    op,
+		"request": fmt.Sprintf("%+v", request),
+	})
+	logger.Info()
+
+	// Forward every batch as it arrives, Send blocks while the flow control
+	// window of the stream is full
+	send := func(transactions []service.Transaction) error {
+		response := &pb.StreamStatementResponse{
+			Transactions: make([]*pb.TransactionInfo, 0, len(transactions)),
+		}
+		for i := range transactions {
+			response.Transactions = append(response.Transactions, toTransactionInfo(&transactions[i]))
+		}
+
+		return stream.Send(response)
+	}
+
+	result, err := api.service.StreamStatement(ctx, &service.StreamStatementParams{
+		AccountNumber: request.AccountNumber,
+		FromTime:      request.FromTime,
+		ToTime:        request.ToTime,
+		BatchSize:     request.BatchSize,
+	}, send)
+	if err != nil {
+		logger.WithError(err).Error()
+
+		// Set span attributes and status
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return apperrors.ToGRPCError(err)
+	}
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.Int("output.transactions", result.Transactions),
+		attribute.Int("output.batches", result.Batches),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return nil
+}
+
+// toTransactionInfo maps a transaction domain model to protobuf
+func toTransactionInfo(transaction *service.Transaction) *pb.TransactionInfo {
+	return &pb.TransactionInfo{
+		TransactionId:   transaction.TransactionID,
+		AccountNumber:   transaction.AccountNumber,
+		TransactionType: transaction.TransactionType,
+		Amount:          transaction.Amount,
+		BalanceBefore:   transaction.BalanceBefore,
+		BalanceAfter:    transaction.BalanceAfter,
+		TransactionTime: transaction.TransactionTime,
+		ReferenceNumber: transaction.ReferenceNumber,
+		Description:     transaction.Description,
+	}
+}
@@ -63,33 +63,81 @@ func (service *Service) GetAccountByAccountNumber(ctx context.Context, params *G
 	}
 
 	// Map adapter models to service domain models
-	result.Account = Account{
-		AccountID:     adapterResult.Account.AccountID,
-		AccountNumber: adapterResult.Account.AccountNumber,
-		CustomerID:    adapterResult.Account.CustomerID,
-		AccountType:   adapterResult.Account.AccountType,
-		AccountStatus: adapterResult.Account.AccountStatus,
-		Balance:       adapterResult.Account.Balance,
-		Currency:      adapterResult.Account.Currency,
-		OpenedDate:    adapterResult.Account.OpenedDate,
-		ClosedDate:    adapterResult.Account.ClosedDate,
-		CreatedAt:     adapterResult.Account.CreatedAt,
-		UpdatedAt:     adapterResult.Account.UpdatedAt,
+	result.Account = Account(adapterResult.Account)
+	result.Customer = Customer(adapterResult.Customer)
+
+	// Set span attributes and status
+	span.SetAttributes(
+		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+	)
+	span.SetStatus(codes.Ok, "success")
+
+	return result, nil
+}
+
+type BatchGetAccountsParams struct {
+	AccountNumbers []string
+}
+
+type BatchGetAccountsResult struct {
+	Results []AccountResult
+}
+
+func (service *Service) BatchGetAccounts(ctx context.Context, params *BatchGetAccountsParams) (*BatchGetAccountsResult, error) {
+	const op = "service.Service.BatchGetAccounts"
+
+	// Start span
+	ctx, span := service.tracer.Start(ctx, op)
+	defer span.End()
+
+	// Set span attributes
+	span.SetAttributes(
+		attribute.String("operation", op),
+		attribute.String("input.params", fmt.Sprintf("%+v", params)),
+	)
+
+	// Initialize result
+	result := &BatchGetAccountsResult{}
+
+	// Get logger with trace id
+	logger := logging.LogWithTrace(ctx, service.logger)
+	logger = logger.WithFields(logrus.Fields{
+		"[op]":   op,
+		"params": fmt.Sprintf("%+v", params),
+	})
+	logger.Info()
+
+	// Call adapter
+	adapterResult, err := service.goCoreAdapter.BatchGetAccounts(ctx, &go_core_adapter.BatchGetAccountsParams{
+		AccountNumbers: params.AccountNumbers,
+	})
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"scope": "Call go-core adapter",
+			"err":   err.Error(),
+		}).Error()
+
+		// Record the error in the span
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
 	}
 
-	result.Customer = Customer{
-		CustomerNumber: adapterResult.Customer.CustomerNumber,
-		FullName:       adapterResult.Customer.FullName,
-		IDNumber:       adapterResult.Customer.IDNumber,
-		PhoneNumber:    adapterResult.Customer.PhoneNumber,
-		Email:          adapterResult.Customer.Email,
-		Address:        adapterResult.Customer.Address,
-		DateOfBirth:    adapterResult.Customer.DateOfBirth,
+	// Map adapter models to service domain models
+	result.Results = make([]AccountResult, 0, len(adapterResult.Results))
+	for _, item := range adapterResult.Results {
+		result.Results = append(result.Results, AccountResult{
+			AccountNumber: item.AccountNumber,
+			Found:         item.Found,
+			Account:       Account(item.Account),
+			Customer:      Customer(item.Customer),
+		})
 	}
 
 	// Set span attributes and status
 	span.SetAttributes(
-		attribute.String("output.result", fmt.Sprintf("%+v", result)),
+		attribute.Int("output.results", len(result.Results)),
 	)
 	span.SetStatus(codes.Ok, "success")
 
@@ -0,0 +1,407 @@
+package bl2
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// StatusConnectionLost is the status of the response returned to requests
+// whose connection failed before they were answered
+const StatusConnectionLost = "996"
+
+const (
+	// ioBufferSize is the size of the buffered reader and writer of a connection
+	ioBufferSize = 32 * 1024
+
+	// writeQueueSize is the number of frames waiting for the writer
+	writeQueueSize = 1024
+
+	// frameBufferSize is the initial capacity of pooled frame buffers
+	frameBufferSize = 512
+)
+
+// ErrClosed is returned when sending on a closed client
+var ErrClosed = errors.New("bl2: client closed")
+
+// frame is a pooled buffer holding an outgoing frame or an incoming payload
+type frame struct {
+	buf []byte
+}
+
+var framePool = sync.Pool{
+	New: func() any {
+		return &frame{buf: make([]byte, 0, frameBufferSize)}
+	},
+}
+
+func getFrame() *frame {
+	return framePool.Get().(*frame)
+}
+
+func putFrame(f *frame) {
+	// Keep unusually large buffers out of the pool
+	if cap(f.buf) > 4*frameBufferSize {
+		return
+	}
+	f.buf = f.buf[:0]
+	framePool.Put(f)
+}
+
+// call is a request waiting for its response. A nil response frame means the
+// connection was lost.
+type call struct {
+	response chan *frame
+}
+
+var callPool = sync.Pool{
+	New: func() any {
+		return &call{response: make(chan *frame, 1)}
+	},
+}
+
+// Client sends BL2 requests over a single pipelined TCP connection and routes
+// each response to its request by id_message. Requests are queued to a single
+// writer goroutine that flushes all queued frames at once; a single reader
+// goroutine hands raw responses back to their callers, which decode them.
+// The connection is opened by the first request and reopened by the next
+// request after a failure.
+type Client struct {
+	logger  *logrus.Logger
+	name    string // Adapter name used in logs
+	address string
+
+	connMutex sync.Mutex
+	conn      *connection
+	closed    bool
+}
+
+// connection is one TCP connection with the requests waiting on it
+type connection struct {
+	conn   net.Conn
+	writes chan *frame
+	done   chan struct{} // closed when the connection is closed
+
+	mutex   sync.Mutex
+	pending map[string]*call
+	closed  bool
+}
+
+// NewClient creates a client for the BL2 server at address
+func NewClient(logger *logrus.Logger, name string, address string) *Client {
+	return &Client{
+		logger:  logger,
+		name:    name,
+		address: address,
+	}
+}
+
+// Address returns the server address
+func (client *Client) Address() string {
+	return client.address
+}
+
+// Connect establishes a connection to the server if not already connected
+func (client *Client) Connect() error {
+	_, err := client.connect()
+
+	return err
+}
+
+func (client *Client) connect() (*connection, error) {
+	const op = "bl2/Client.connect"
+
+	client.connMutex.Lock()
+	defer client.connMutex.Unlock()
+
+	if client.closed {
+		return nil, ErrClosed
+	}
+	if client.conn != nil {
+		return client.conn, nil
+	}
+
+	client.logger.WithFields(logrus.Fields{
+		"op":      op,
+		"adapter": client.name,
+		"address": client.address,
+	}).Info("Establishing connection to server")
+
+	conn, err := net.Dial("tcp", client.address)
+	if err != nil {
+		client.logger.WithFields(logrus.Fields{
+			"op":      op,
+			"adapter": client.name,
+			"address": client.address,
+			"err":     err.Error(),
+		}).Error("Failed to connect to server")
+
+		return nil, fmt.Errorf("error connecting to server: %w", err)
+	}
+
+	client.conn = &connection{
+		conn:    conn,
+		writes:  make(chan *frame, writeQueueSize),
+		done:    make(chan struct{}),
+		pending: make(map[string]*call),
+	}
+
+	// Each connection has its own writer and reader, they end when it fails
+	go client.writeRequests(client.conn)
+	go client.readResponses(client.conn)
+
+	client.logger.WithFields(logrus.Fields{
+		"op":      op,
+		"adapter": client.name,
+		"address": client.address,
+	}).Info("TCP connection established")
+
+	return client.conn, nil
+}
+
+// Send sends a JSON request and waits for the response with the same
+// id_message. There is no client-side timeout; requests waiting on a failed
+// connection get a response with status 996.
+func (client *Client) Send(payload []byte) (map[string]any, error) {
+	id, ok := scanMessageID(payload)
+	if !ok {
+		return nil, fmt.Errorf("request payload missing id_message field")
+	}
+	messageID := string(id)
+
+	if len(payload) > MaxFrameSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, len(payload))
+	}
+
+	conn, err := client.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.register(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	f := getFrame()
+	f.buf = binary.BigEndian.AppendUint16(f.buf, uint16(len(payload)))
+	f.buf = append(f.buf, payload...)
+
+	select {
+	case conn.writes <- f:
+	case <-conn.done:
+		// The connection failed before the request was queued; close
+		// answers every registered call, so wait for that answer below
+		putFrame(f)
+	}
+
+	responseFrame := <-c.response
+	callPool.Put(c)
+
+	if responseFrame == nil {
+		return connectionLost(messageID), nil
+	}
+	defer putFrame(responseFrame)
+
+	var response map[string]any
+	if err := json.Unmarshal(responseFrame.buf, &response); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	return response, nil
+}
+
+// writeRequests writes queued frames to conn, flushing once the queue is empty
+func (client *Client) writeRequests(conn *connection) {
+	w := bufio.NewWriterSize(conn.conn, ioBufferSize)
+
+	write := func(f *frame) {
+		w.Write(f.buf) // Errors are sticky and reported by Flush
+		putFrame(f)
+	}
+
+	for {
+		select {
+		case f := <-conn.writes:
+			write(f)
+
+			// Batch every frame already queued into the same flush
+			for batching := true; batching; {
+				select {
+				case f := <-conn.writes:
+					write(f)
+				default:
+					batching = false
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				client.disconnect(conn, err)
+				return
+			}
+
+		case <-conn.done:
+			return
+		}
+	}
+}
+
+// readResponses reads responses from conn until it fails and hands them to
+// the waiting requests
+func (client *Client) readResponses(conn *connection) {
+	const op = "bl2/Client.readResponses"
+
+	r := bufio.NewReaderSize(conn.conn, ioBufferSize)
+	for {
+		f := getFrame()
+		var err error
+		if f.buf, err = readPayload(r, f.buf); err != nil {
+			putFrame(f)
+			client.disconnect(conn, err)
+			return
+		}
+
+		id, ok := scanMessageID(f.buf)
+		if !ok {
+			client.logger.WithFields(logrus.Fields{
+				"op":       op,
+				"adapter":  client.name,
+				"response": string(f.buf),
+			}).Error("Response missing id_message")
+
+			putFrame(f)
+			continue
+		}
+
+		if !conn.deliver(id, f) {
+			client.logger.WithFields(logrus.Fields{
+				"op":         op,
+				"adapter":    client.name,
+				"id_message": string(id),
+			}).Warn("No waiting request found for id_message")
+
+			putFrame(f)
+		}
+	}
+}
+
+// disconnect closes conn after a failure and fails its waiting requests
+func (client *Client) disconnect(conn *connection, cause error) {
+	const op = "bl2/Client.disconnect"
+
+	client.connMutex.Lock()
+	if client.conn == conn {
+		client.conn = nil
+	}
+	client.connMutex.Unlock()
+
+	if conn.close() && !client.isClosed() {
+		client.logger.WithFields(logrus.Fields{
+			"op":      op,
+			"adapter": client.name,
+			"address": client.address,
+			"err":     cause.Error(),
+		}).Error("Connection lost")
+	}
+}
+
+func (client *Client) isClosed() bool {
+	client.connMutex.Lock()
+	defer client.connMutex.Unlock()
+
+	return client.closed
+}
+
+// Close closes the connection to the server. Later requests fail with ErrClosed.
+func (client *Client) Close() {
+	const op = "bl2/Client.Close"
+
+	client.connMutex.Lock()
+	conn := client.conn
+	client.conn = nil
+	client.closed = true
+	client.connMutex.Unlock()
+
+	if conn == nil {
+		return
+	}
+
+	client.logger.WithFields(logrus.Fields{
+		"op":      op,
+		"adapter": client.name,
+		"address": client.address,
+	}).Info("Closing connection to server")
+
+	conn.close()
+}
+
+// connectionLost is the response given to requests of a failed connection
+func connectionLost(messageID string) map[string]any {
+	return map[string]any{
+		"err_info":   "connection lost",
+		"status":     StatusConnectionLost,
+		"id_message": messageID,
+	}
+}
+
+// register adds a request waiting for its response
+func (conn *connection) register(messageID string) (*call, error) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	if conn.closed {
+		return nil, fmt.Errorf("error sending request: connection lost")
+	}
+	if _, exists := conn.pending[messageID]; exists {
+		return nil, fmt.Errorf("duplicate id_message in flight: %s", messageID)
+	}
+
+	c := callPool.Get().(*call)
+	conn.pending[messageID] = c
+
+	return c, nil
+}
+
+// deliver hands a response to its waiting request, reporting whether there was one
+func (conn *connection) deliver(messageID []byte, f *frame) bool {
+	conn.mutex.Lock()
+	c, exists := conn.pending[string(messageID)]
+	if exists {
+		delete(conn.pending, string(messageID))
+	}
+	conn.mutex.Unlock()
+
+	if exists {
+		// Buffered, never blocks the reader
+		c.response <- f
+	}
+
+	return exists
+}
+
+// close closes the connection once and fails every waiting request. It
+// reports whether this call closed it.
+func (conn *connection) close() bool {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+
+	if conn.closed {
+		return false
+	}
+	conn.closed = true
+	close(conn.done)
+	conn.conn.Close()
+
+	for messageID, c := range conn.pending {
+		c.response <- nil
+		delete(conn.pending, messageID)
+	}
+
+	return true
+}
@@ -0,0 +1,99 @@
+package bl2
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newEchoServer starts a server writing every frame back unchanged. Requests
+// carry their id_message, so each echoed frame is a valid response. It keeps
+// allocations on the server side out of the client numbers.
+func newEchoServer(b *testing.B) string {
+	b.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		b.Fatalf("listen: %v", err)
+	}
+	b.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				r := bufio.NewReader(conn)
+				w := bufio.NewWriter(conn)
+				buf := make([]byte, MaxFrameSize+headerSize)
+				for {
+					if _, err := io.ReadFull(r, buf[:headerSize]); err != nil {
+						return
+					}
+					n := headerSize + int(binary.BigEndian.Uint16(buf))
+					if _, err := io.ReadFull(r, buf[headerSize:n]); err != nil {
+						return
+					}
+					w.Write(buf[:n])
+					if r.Buffered() == 0 {
+						if err := w.Flush(); err != nil {
+							return
+						}
+					}
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func BenchmarkClientSend(b *testing.B) {
+	client := newTestClient(newEchoServer(b))
+	defer client.Close()
+
+	var ids atomic.Int64
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			id := strconv.FormatInt(ids.Add(1), 10)
+			payload := []byte(`{"id_message":"` + id + `","operation":"get_account_by_account_number","params":{"account_number":"1234567890"}}`)
+
+			response, err := client.Send(payload)
+			if err != nil {
+				b.Fatalf("Send: %v", err)
+			}
+			if response["id_message"] != id {
+				b.Fatalf("response %v for request %s", response, id)
+			}
+		}
+	})
+}
+
+func BenchmarkClientSendSequential(b *testing.B) {
+	client := newTestClient(newEchoServer(b))
+	defer client.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		payload := []byte(fmt.Sprintf(`{"id_message":"%d","operation":"get_account_by_account_number","params":{"account_number":"1234567890"}}`, i))
+		if _, err := client.Send(payload); err != nil {
+			b.Fatalf("Send: %v", err)
+		}
+	}
+}
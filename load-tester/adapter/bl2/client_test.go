@@ -0,0 +1,260 @@
+package bl2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fakeServer is an in-process BL2 server. handle receives each request and
+// the connection writer; it answers by calling reply, in any order.
+type fakeServer struct {
+	listener net.Listener
+	handle   func(request map[string]any, reply func(response map[string]any))
+
+	wg sync.WaitGroup
+}
+
+func newFakeServer(t *testing.T, handle func(request map[string]any, reply func(response map[string]any))) *fakeServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	server := &fakeServer{listener: listener, handle: handle}
+	server.wg.Add(1)
+	go server.serve()
+
+	t.Cleanup(func() {
+		listener.Close()
+		server.wg.Wait()
+	})
+
+	return server
+}
+
+func (server *fakeServer) serve() {
+	defer server.wg.Done()
+
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		server.wg.Add(1)
+		go func() {
+			defer server.wg.Done()
+			defer conn.Close()
+
+			var writeMutex sync.Mutex
+			reply := func(response map[string]any) {
+				data, _ := json.Marshal(response)
+
+				writeMutex.Lock()
+				defer writeMutex.Unlock()
+				WriteFrame(conn, data)
+			}
+
+			for {
+				frame, err := ReadFrame(conn)
+				if err != nil {
+					return
+				}
+
+				var request map[string]any
+				if err := json.Unmarshal(frame, &request); err != nil {
+					return
+				}
+				server.handle(request, reply)
+			}
+		}()
+	}
+}
+
+func (server *fakeServer) address() string {
+	return server.listener.Addr().String()
+}
+
+// echo answers every request with status 000
+func echo(request map[string]any, reply func(response map[string]any)) {
+	reply(map[string]any{
+		"id_message": request["id_message"],
+		"status":     "000",
+		"params":     request["params"],
+	})
+}
+
+func newTestClient(address string) *Client {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	return NewClient(logger, "test", address)
+}
+
+func request(id string) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"id_message": id,
+		"operation":  "get_account_by_account_number",
+		"params":     map[string]any{"account_number": id},
+	})
+
+	return data
+}
+
+func TestClientSend(t *testing.T) {
+	server := newFakeServer(t, echo)
+	client := newTestClient(server.address())
+	defer client.Close()
+
+	response, err := client.Send(request("1"))
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if response["id_message"] != "1" || response["status"] != "000" {
+		t.Fatalf("response = %v", response)
+	}
+}
+
+func TestClientCorrelatesOutOfOrderResponses(t *testing.T) {
+	const n = 50
+
+	// Hold every request until all have arrived, then answer in reverse order
+	var mutex sync.Mutex
+	var held []map[string]any
+	var replyFn func(map[string]any)
+	server := newFakeServer(t, func(request map[string]any, reply func(map[string]any)) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		held = append(held, request)
+		replyFn = reply
+		if len(held) == n {
+			for i := len(held) - 1; i >= 0; i-- {
+				echo(held[i], replyFn)
+			}
+		}
+	})
+
+	client := newTestClient(server.address())
+	defer client.Close()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+
+			response, err := client.Send(request(id))
+			if err != nil {
+				errs <- err
+				return
+			}
+			params, _ := response["params"].(map[string]any)
+			if response["id_message"] != id || params["account_number"] != id {
+				errs <- fmt.Errorf("request %s got response %v", id, response)
+			}
+		}(fmt.Sprint(i))
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestClientConnectionLost(t *testing.T) {
+	// Never answer, the connection breaks instead
+	received := make(chan struct{})
+	server := newFakeServer(t, func(request map[string]any, reply func(map[string]any)) {
+		close(received)
+	})
+
+	client := newTestClient(server.address())
+	defer client.Close()
+
+	done := make(chan map[string]any, 1)
+	go func() {
+		response, err := client.Send(request("1"))
+		if err != nil {
+			t.Errorf("Send: %v", err)
+		}
+		done <- response
+	}()
+
+	<-received
+	client.connMutex.Lock()
+	client.conn.conn.Close()
+	client.connMutex.Unlock()
+
+	select {
+	case response := <-done:
+		if response["status"] != StatusConnectionLost || response["id_message"] != "1" {
+			t.Fatalf("response = %v", response)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not failed after connection loss")
+	}
+}
+
+func TestClientReconnects(t *testing.T) {
+	server := newFakeServer(t, echo)
+	client := newTestClient(server.address())
+	defer client.Close()
+
+	if _, err := client.Send(request("1")); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	// Break the connection, the next request opens a new one
+	client.connMutex.Lock()
+	conn := client.conn
+	client.connMutex.Unlock()
+	client.disconnect(conn, io.EOF)
+
+	response, err := client.Send(request("2"))
+	if err != nil {
+		t.Fatalf("Send after reconnect: %v", err)
+	}
+	if response["id_message"] != "2" {
+		t.Fatalf("response = %v", response)
+	}
+}
+
+func TestClientRejectsInvalidRequests(t *testing.T) {
+	client := newTestClient("127.0.0.1:0")
+	defer client.Close()
+
+	tests := map[string][]byte{
+		"invalid json":       []byte(`{`),
+		"missing id_message": []byte(`{"operation":"x"}`),
+	}
+
+	for name, payload := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := client.Send(payload); err == nil {
+				t.Fatal("Send accepted an invalid request")
+			}
+		})
+	}
+}
+
+func TestClientClosed(t *testing.T) {
+	server := newFakeServer(t, echo)
+	client := newTestClient(server.address())
+	client.Close()
+
+	if _, err := client.Send(request("1")); err != ErrClosed {
+		t.Fatalf("err = %v, want ErrClosed", err)
+	}
+}
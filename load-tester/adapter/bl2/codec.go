@@ -0,0 +1,233 @@
+package bl2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// MaxFrameSize is the largest payload the 2-byte length prefix can describe
+const MaxFrameSize = 0xffff
+
+// headerSize is the size of the big-endian length prefix
+const headerSize = 2
+
+// ErrFrameTooLarge is returned when a payload does not fit in a frame
+var ErrFrameTooLarge = errors.New("bl2: frame too large")
+
+// WriteFrame writes payload prefixed with its length in a single write
+func WriteFrame(w io.Writer, payload []byte) error {
+	if len(payload) > MaxFrameSize {
+		return fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, len(payload))
+	}
+
+	frame := make([]byte, headerSize+len(payload))
+	binary.BigEndian.PutUint16(frame, uint16(len(payload)))
+	copy(frame[headerSize:], payload)
+
+	_, err := w.Write(frame)
+
+	return err
+}
+
+// ReadFrame reads a single length-prefixed payload. Short reads are retried
+// until the whole frame has arrived; a stream ending inside a frame returns
+// io.ErrUnexpectedEOF and a stream ending between frames io.EOF.
+func ReadFrame(r io.Reader) ([]byte, error) {
+	return readPayload(r, nil)
+}
+
+// readPayload reads a single frame into buf, growing it if needed, and
+// returns the payload
+func readPayload(r io.Reader, buf []byte) ([]byte, error) {
+	var header [headerSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return buf, err
+	}
+
+	n := int(binary.BigEndian.Uint16(header[:]))
+	if cap(buf) < n {
+		buf = make([]byte, n)
+	}
+	buf = buf[:n]
+
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return buf, err
+	}
+
+	return buf, nil
+}
+
+// MessageID returns the id_message of a decoded message
+func MessageID(message map[string]any) (string, bool) {
+	id, ok := message["id_message"].(string)
+
+	return id, ok
+}
+
+// scanMessageID returns the top-level id_message string of a JSON object
+// without decoding the rest of it. Like encoding/json, the last occurrence of
+// a duplicated key wins. The result aliases data unless the value had to be
+// unescaped.
+func scanMessageID(data []byte) ([]byte, bool) {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != '{' {
+		return nil, false
+	}
+	i++
+
+	var id []byte
+	found := false
+	for {
+		i = skipSpace(data, i)
+		if i >= len(data) {
+			return nil, false
+		}
+		if data[i] == '}' {
+			return id, found
+		}
+
+		keyStart := i
+		i = skipString(data, i)
+		if i < 0 {
+			return nil, false
+		}
+		key := data[keyStart:i]
+
+		i = skipSpace(data, i)
+		if i >= len(data) || data[i] != ':' {
+			return nil, false
+		}
+		i = skipSpace(data, i+1)
+
+		valueStart := i
+		i = skipValue(data, i)
+		if i < 0 {
+			return nil, false
+		}
+
+		if string(key) == `"id_message"` {
+			id, found = unquote(data[valueStart:i])
+		}
+
+		i = skipSpace(data, i)
+		if i >= len(data) {
+			return nil, false
+		}
+		switch data[i] {
+		case ',':
+			i++
+		case '}':
+			return id, found
+		default:
+			return nil, false
+		}
+	}
+}
+
+// unquote returns the content of a JSON string token. Tokens with escapes or
+// invalid UTF-8 are decoded with encoding/json so the result matches it.
+func unquote(token []byte) ([]byte, bool) {
+	if len(token) < 2 || token[0] != '"' {
+		return nil, false
+	}
+
+	content := token[1 : len(token)-1]
+	if bytes.IndexByte(content, '\\') < 0 && utf8.Valid(content) {
+		return content, true
+	}
+
+	var s string
+	if err := json.Unmarshal(token, &s); err != nil {
+		return nil, false
+	}
+
+	return []byte(s), true
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+
+	return i
+}
+
+// skipString returns the index after the string starting at i, or -1
+func skipString(data []byte, i int) int {
+	if i >= len(data) || data[i] != '"' {
+		return -1
+	}
+
+	for i++; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+
+	return -1
+}
+
+// skipValue returns the index after the value starting at i, or -1
+func skipValue(data []byte, i int) int {
+	if i >= len(data) {
+		return -1
+	}
+
+	switch data[i] {
+	case '"':
+		return skipString(data, i)
+
+	case '{', '[':
+		depth := 0
+		for i < len(data) {
+			switch data[i] {
+			case '"':
+				i = skipString(data, i)
+				if i < 0 {
+					return -1
+				}
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1
+				}
+			}
+			i++
+		}
+		return -1
+
+	default:
+		// Number, true, false or null
+		start := i
+		for i < len(data) {
+			switch data[i] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				if i == start {
+					return -1
+				}
+				return i
+			}
+			i++
+		}
+		return -1
+	}
+}
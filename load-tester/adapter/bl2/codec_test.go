@@ -0,0 +1,208 @@
+package bl2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestWriteFrame(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFrame(&buf, []byte(`{"a":1}`)); err != nil {
+		t.Fatalf("WriteFrame: %v", err)
+	}
+
+	want := append([]byte{0x00, 0x07}, `{"a":1}`...)
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("frame = %v, want %v", buf.Bytes(), want)
+	}
+}
+
+func TestWriteFrameTooLarge(t *testing.T) {
+	err := WriteFrame(io.Discard, make([]byte, MaxFrameSize+1))
+	if !errors.Is(err, ErrFrameTooLarge) {
+		t.Fatalf("err = %v, want ErrFrameTooLarge", err)
+	}
+}
+
+func TestReadFrame(t *testing.T) {
+	var buf bytes.Buffer
+	for _, payload := range []string{`{"id_message":"1"}`, ``, `{"id_message":"2"}`} {
+		if err := WriteFrame(&buf, []byte(payload)); err != nil {
+			t.Fatalf("WriteFrame: %v", err)
+		}
+	}
+
+	// One byte per read, the length prefix must not be taken from a short read
+	r := iotest.OneByteReader(&buf)
+	for _, want := range []string{`{"id_message":"1"}`, ``, `{"id_message":"2"}`} {
+		got, err := ReadFrame(r)
+		if err != nil {
+			t.Fatalf("ReadFrame: %v", err)
+		}
+		if string(got) != want {
+			t.Fatalf("payload = %q, want %q", got, want)
+		}
+	}
+
+	if _, err := ReadFrame(r); err != io.EOF {
+		t.Fatalf("err = %v, want io.EOF", err)
+	}
+}
+
+func TestReadFrameTruncated(t *testing.T) {
+	tests := map[string][]byte{
+		"header":  {0x00},
+		"payload": {0x00, 0x05, 'a', 'b'},
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadFrame(bytes.NewReader(data))
+			if err != io.ErrUnexpectedEOF {
+				t.Fatalf("err = %v, want io.ErrUnexpectedEOF", err)
+			}
+		})
+	}
+}
+
+func TestMessageID(t *testing.T) {
+	if id, ok := MessageID(map[string]any{"id_message": "abc"}); !ok || id != "abc" {
+		t.Fatalf("MessageID = %q, %v", id, ok)
+	}
+	if _, ok := MessageID(map[string]any{"id_message": 1}); ok {
+		t.Fatal("MessageID accepted a non-string id")
+	}
+	if _, ok := MessageID(map[string]any{}); ok {
+		t.Fatal("MessageID accepted a missing id")
+	}
+}
+
+func FuzzFrameRoundTrip(f *testing.F) {
+	f.Add([]byte(`{"id_message":"1","status":"000"}`))
+	f.Add([]byte{})
+	f.Add([]byte{0x00, 0xff})
+
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		var buf bytes.Buffer
+		err := WriteFrame(&buf, payload)
+		if len(payload) > MaxFrameSize {
+			if !errors.Is(err, ErrFrameTooLarge) {
+				t.Fatalf("err = %v, want ErrFrameTooLarge", err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("WriteFrame: %v", err)
+		}
+
+		got, err := ReadFrame(iotest.HalfReader(&buf))
+		if err != nil {
+			t.Fatalf("ReadFrame: %v", err)
+		}
+		if !bytes.Equal(got, payload) {
+			t.Fatalf("payload = %v, want %v", got, payload)
+		}
+	})
+}
+
+func FuzzReadFrame(f *testing.F) {
+	f.Add([]byte{0x00, 0x02, '{', '}'})
+	f.Add([]byte{0xff, 0xff})
+	f.Add([]byte{0x00})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := bytes.NewReader(data)
+		for {
+			payload, err := ReadFrame(r)
+			if err != nil {
+				if err != io.EOF && err != io.ErrUnexpectedEOF {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if len(payload) > MaxFrameSize {
+				t.Fatalf("payload of %d bytes", len(payload))
+			}
+		}
+	})
+}
+
+func TestScanMessageID(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		id    string
+		found bool
+	}{
+		{"first key", `{"id_message":"abc","status":"000"}`, "abc", true},
+		{"after nested values", `{"params":{"id_message":"inner","list":[1,"}",{"a":null}]},"id_message":"outer"}`, "outer", true},
+		{"whitespace", " {\n\t\"id_message\" : \"abc\" }", "abc", true},
+		{"escaped", `{"id_message":"a\"bA"}`, `a"bA`, true},
+		{"duplicate key", `{"id_message":"1","id_message":"2"}`, "2", true},
+		{"only nested", `{"params":{"id_message":"inner"}}`, "", false},
+		{"not a string", `{"id_message":123}`, "", false},
+		{"missing", `{"status":"000"}`, "", false},
+		{"not an object", `["id_message","abc"]`, "", false},
+		{"truncated", `{"id_message":"abc"`, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, found := scanMessageID([]byte(test.data))
+			if found != test.found || string(id) != test.id {
+				t.Fatalf("scanMessageID = %q, %v, want %q, %v", id, found, test.id, test.found)
+			}
+		})
+	}
+}
+
+// FuzzScanMessageID checks that scanning agrees with a full decode on valid JSON
+func FuzzScanMessageID(f *testing.F) {
+	f.Add([]byte(`{"id_message":"abc","params":{"account_number":"1"}}`))
+	f.Add([]byte(`{"a":[1,2,{"b":"}"}],"id_message":"x\"y"}`))
+	f.Add([]byte(`{"id_message":null}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		id, found := scanMessageID(data)
+
+		var message map[string]any
+		if err := json.Unmarshal(data, &message); err != nil {
+			return
+		}
+
+		want, ok := MessageID(message)
+		if found != ok || string(id) != want {
+			t.Fatalf("scanMessageID(%q) = %q, %v, want %q, %v", data, id, found, want, ok)
+		}
+	})
+}
+
+func BenchmarkMessageID(b *testing.B) {
+	data := []byte(`{"id_message":"2025010112000012345678901234567890","operation":"get_account_by_account_number","params":{"account_number":"1234567890"}}`)
+
+	b.Run("scan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, ok := scanMessageID(data); !ok {
+				b.Fatal("id_message not found")
+			}
+		}
+	})
+
+	b.Run("decode", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var message map[string]any
+			if err := json.Unmarshal(data, &message); err != nil {
+				b.Fatal(err)
+			}
+			if _, ok := MessageID(message); !ok {
+				b.Fatal("id_message not found")
+			}
+		}
+	})
+}
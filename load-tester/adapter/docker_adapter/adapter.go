@@ -0,0 +1,43 @@
+package docker_adapter
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// requestTimeout bounds a single Docker Engine API request
+const requestTimeout = 5 * time.Second
+
+// Adapter is a minimal Docker Engine API client over the daemon unix socket
+type Adapter struct {
+	logger     *logrus.Logger
+	socketPath string
+
+	client *http.Client
+}
+
+// NewAdapter creates a new Docker adapter for the daemon socket at socketPath
+func NewAdapter(
+	logger *logrus.Logger,
+	socketPath string,
+) *Adapter {
+	dialer := &net.Dialer{}
+
+	return &Adapter{
+		logger:     logger,
+		socketPath: socketPath,
+
+		client: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
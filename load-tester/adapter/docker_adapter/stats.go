@@ -0,0 +1,87 @@
+package docker_adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ContainerStats is a single resource sample of a container
+type ContainerStats struct {
+	Time        time.Time
+	CPUUsageNs  uint64 // Cumulative CPU time used by the container
+	MemoryBytes uint64 // Memory usage without the inactive page cache
+}
+
+// statsResponse is the part of the Docker stats response that is used
+type statsResponse struct {
+	Read     time.Time `json:"read"`
+	CPUStats struct {
+		CPUUsage struct {
+			TotalUsage uint64 `json:"total_usage"`
+		} `json:"cpu_usage"`
+	} `json:"cpu_stats"`
+	MemoryStats struct {
+		Usage uint64            `json:"usage"`
+		Stats map[string]uint64 `json:"stats"`
+	} `json:"memory_stats"`
+}
+
+// GetContainerStats takes a single resource sample of the named container
+func (adapter *Adapter) GetContainerStats(ctx context.Context, name string) (*ContainerStats, error) {
+	const op = "docker_adapter/GetContainerStats"
+
+	// one-shot skips the second sample the daemon would otherwise wait for
+	endpoint := fmt.Sprintf("http://docker/containers/%s/stats?stream=false&one-shot=true", url.PathEscape(name))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := adapter.client.Do(req)
+	if err != nil {
+		adapter.logger.WithFields(logrus.Fields{
+			"op":        op,
+			"container": name,
+			"err":       err.Error(),
+		}).Debug("Failed to get container stats")
+
+		return nil, fmt.Errorf("error getting stats of %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting stats of %s: status %d", name, resp.StatusCode)
+	}
+
+	var stats statsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("error decoding stats of %s: %w", name, err)
+	}
+
+	// Same as docker stats: cgroup v2 reports inactive_file, v1 total_inactive_file
+	memory := stats.MemoryStats.Usage
+	inactive, ok := stats.MemoryStats.Stats["inactive_file"]
+	if !ok {
+		inactive = stats.MemoryStats.Stats["total_inactive_file"]
+	}
+	if inactive < memory {
+		memory -= inactive
+	}
+
+	sampledAt := stats.Read
+	if sampledAt.IsZero() {
+		sampledAt = time.Now()
+	}
+
+	return &ContainerStats{
+		Time:        sampledAt,
+		CPUUsageNs:  stats.CPUStats.CPUUsage.TotalUsage,
+		MemoryBytes: memory,
+	}, nil
+}
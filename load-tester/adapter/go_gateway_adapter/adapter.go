@@ -1,6 +1,8 @@
 package go_gateway_adapter
 
 import (
+	"sync/atomic"
+
 	pb "load-tester/adapter/go_gateway_adapter/pb"
 
 	"github.com/sirupsen/logrus"
@@ -11,11 +13,15 @@ import (
 // Adapter is a wrapper around the grpc client
 type Adapter struct {
 	serviceName string
+	target      string
 
 	logger *logrus.Logger
 	tracer trace.Tracer
 
-	goGatewayClient pb.GoGatewayClient
+	// conns are used round-robin, owned when created by WithOptions
+	conns []*subConn
+	owned bool
+	next  atomic.Uint64
 }
 
 // NewAdapter creates a new grpc adapter
@@ -29,10 +35,13 @@ func NewAdapter(
 
 	return &Adapter{
 		serviceName: serviceName,
+		target:      cc.Target(),
 
 		logger: logger,
 		tracer: tracer,
 
-		goGatewayClient: goGatewayClient,
+		conns: []*subConn{
+			{conn: cc, client: goGatewayClient},
+		},
 	}
 }
@@ -0,0 +1,189 @@
+package go_gateway_adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "load-tester/adapter/go_gateway_adapter/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Load balancing policies
+const (
+	LoadBalancingPickFirst  = "pick_first"
+	LoadBalancingRoundRobin = "round_robin"
+)
+
+// Compressors
+const (
+	CompressionNone = "none"
+	CompressionGzip = gzip.Name
+)
+
+// ClientOptions tunes the gRPC client connections. Zero values keep the grpc
+// defaults.
+type ClientOptions struct {
+	SubConnections        int           // Client connections, calls are spread round-robin over them, defaults to 1
+	LoadBalancingPolicy   string        // Policy over the resolved addresses of each connection, "pick_first" or "round_robin"
+	KeepaliveTime         time.Duration // Ping interval on idle connections, disabled if 0
+	KeepaliveTimeout      time.Duration // Wait for a ping ack before closing the connection
+	KeepaliveWithoutCalls bool          // Send pings even without active calls
+	InitialWindowSize     int32         // Per-stream flow control window, grpc ignores values below 64KB
+	InitialConnWindowSize int32         // Per-connection flow control window, grpc ignores values below 64KB
+	MaxConcurrentStreams  int           // Client-side cap on calls in flight per connection, unbounded if 0
+	Compression           string        // "gzip" or "none", the server must have the compressor registered
+}
+
+// subConn is one client connection with its optional stream slots
+type subConn struct {
+	conn   *grpc.ClientConn
+	client pb.GoGatewayClient
+
+	// streams is nil when calls on the connection are unbounded
+	streams chan struct{}
+}
+
+// acquire takes a stream slot on the connection, waiting for ctx if all are in use
+func (sc *subConn) acquire(ctx context.Context) error {
+	if sc.streams == nil {
+		return nil
+	}
+
+	select {
+	case sc.streams <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (sc *subConn) release() {
+	if sc.streams != nil {
+		<-sc.streams
+	}
+}
+
+// dialOptions converts options into grpc dial options
+func dialOptions(options *ClientOptions) ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	switch options.LoadBalancingPolicy {
+	case "":
+	case LoadBalancingPickFirst, LoadBalancingRoundRobin:
+		serviceConfig, err := json.Marshal(map[string]any{
+			"loadBalancingConfig": []map[string]any{
+				{options.LoadBalancingPolicy: map[string]any{}},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithDefaultServiceConfig(string(serviceConfig)))
+	default:
+		return nil, fmt.Errorf("unsupported load balancing policy: %s", options.LoadBalancingPolicy)
+	}
+
+	if options.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                options.KeepaliveTime,
+			Timeout:             options.KeepaliveTimeout,
+			PermitWithoutStream: options.KeepaliveWithoutCalls,
+		}))
+	}
+
+	if options.InitialWindowSize > 0 {
+		opts = append(opts, grpc.WithInitialWindowSize(options.InitialWindowSize))
+	}
+
+	if options.InitialConnWindowSize > 0 {
+		opts = append(opts, grpc.WithInitialConnWindowSize(options.InitialConnWindowSize))
+	}
+
+	switch options.Compression {
+	case "", CompressionNone:
+	case CompressionGzip:
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", options.Compression)
+	}
+
+	return opts, nil
+}
+
+// WithOptions creates a new adapter to the same target with its own client
+// connections configured by options. The caller must Close it.
+func (adapter *Adapter) WithOptions(options *ClientOptions) (*Adapter, error) {
+	opts, err := dialOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	n := max(options.SubConnections, 1)
+
+	clone := &Adapter{
+		serviceName: adapter.serviceName,
+		target:      adapter.target,
+
+		logger: adapter.logger,
+		tracer: adapter.tracer,
+
+		conns: make([]*subConn, 0, n),
+		owned: true,
+	}
+
+	for i := 0; i < n; i++ {
+		// Each client connection gets its own HTTP/2 transport
+		conn, err := grpc.NewClient(adapter.target, opts...)
+		if err != nil {
+			clone.Close()
+
+			return nil, fmt.Errorf("error connecting to %s grpc server: %w", adapter.serviceName, err)
+		}
+
+		sc := &subConn{
+			conn:   conn,
+			client: pb.NewGoGatewayClient(conn),
+		}
+		if options.MaxConcurrentStreams > 0 {
+			sc.streams = make(chan struct{}, options.MaxConcurrentStreams)
+		}
+
+		clone.conns = append(clone.conns, sc)
+	}
+
+	return clone, nil
+}
+
+// pick returns the next connection in round-robin order
+func (adapter *Adapter) pick() *subConn {
+	if len(adapter.conns) == 1 {
+		return adapter.conns[0]
+	}
+
+	return adapter.conns[adapter.next.Add(1)%uint64(len(adapter.conns))]
+}
+
+// Close closes the connections created by WithOptions. Connections passed to
+// NewAdapter are owned by the caller and left open.
+func (adapter *Adapter) Close() error {
+	if !adapter.owned {
+		return nil
+	}
+
+	var firstErr error
+	for _, sc := range adapter.conns {
+		if err := sc.conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
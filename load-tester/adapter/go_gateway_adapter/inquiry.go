@@ -52,8 +52,16 @@ func (adapter *Adapter) GetAccountByAccountNumber(ctx context.Context, params *G
 		AccountNumber: params.AccountNumber,
 	}
 
-	// Call external service
-	response, err := adapter.goGatewayClient.GetAccountByAccountNumber(ctx, request)
+	// Call external service on the next connection
+	sc := adapter.pick()
+	if err := sc.acquire(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, fmt.Errorf("error waiting for a stream: %w", err)
+	}
+	response, err := sc.client.GetAccountByAccountNumber(ctx, request)
+	sc.release()
 	if err != nil {
 		logger.WithFields(logrus.Fields{
 			"scope": "Get account by account number",
@@ -1,24 +1,14 @@
 package py_core_adapter
 
 import (
-	"net"
-	"sync"
+	"load-tester/adapter/bl2"
 
 	"github.com/sirupsen/logrus"
 )
 
 type Adapter struct {
-	logger  *logrus.Logger
-	address string
-
-	// Connection management
-	conn        net.Conn
-	connMutex   sync.Mutex
-	isConnected bool
-
-	// Response tracking
-	pendingRequests map[string]chan map[string]any
-	requestsMutex   sync.RWMutex
+	logger *logrus.Logger
+	client *bl2.Client
 }
 
 // NewAdapter creates a new TCP adapter instance
@@ -26,14 +16,18 @@ func NewAdapter(
 	logger *logrus.Logger,
 	address string,
 ) *Adapter {
-	adapter := &Adapter{
-		logger:          logger,
-		address:         address,
-		pendingRequests: make(map[string]chan map[string]any),
+	return &Adapter{
+		logger: logger,
+		client: bl2.NewClient(logger, "py_core_adapter", address),
 	}
+}
 
-	// Start response reader goroutine when creating the adapter
-	go adapter.readResponses()
+// Connect establishes a connection to the server if not already connected
+func (adapter *Adapter) Connect() error {
+	return adapter.client.Connect()
+}
 
-	return adapter
+// Close closes the connection to the server
+func (adapter *Adapter) Close() {
+	adapter.client.Close()
 }
@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"strings"
 
+	"load-tester/adapter/go_gateway_adapter"
 	"load-tester/service"
 	"load-tester/util/errs"
 
@@ -33,6 +35,16 @@ func (api *Api) DefineEndpoints(app *fiber.App) *fiber.App {
 	loadTest.Post("/duration", api.loadDuration)
 	loadTest.Post("/incremental", api.loadIncremental)
 	loadTest.Post("/rps", api.loadRps)
+	loadTest.Post("/spike", api.loadSpike)
+	loadTest.Post("/search", api.loadSearch)
+	loadTest.Post("/replay", api.loadReplay)
+
+	// Stored Run Routes
+	runs := app.Group("/runs")
+	runs.Get("/", api.listRuns)
+	runs.Get("/:id", api.getRun)
+	runs.Get("/:id/compare", api.compareRun)
+	runs.Put("/:id/baseline", api.setBaseline)
 
 	return app
 }
@@ -47,15 +59,113 @@ func (api *Api) validateBaseParam(param *service.BaseParam) error {
 		return errs.E(errs.Validation, "protocol is required")
 	}
 
-	if param.Protocol != "bl2" && param.Protocol != "grpc" {
-		return errs.E(errs.Validation, fmt.Sprintf("invalid protocol: %s, must be either 'bl2' or 'grpc'", param.Protocol))
+	if err := api.validateProtocol(param.Protocol); err != nil {
+		return err
 	}
 
-	// Validate payload
-	if err := api.validatePayload(&param.Payload); err != nil {
+	if err := api.validateSendParam(param); err != nil {
 		return err
 	}
 
+	// Validate the operation mix, or the payload without one
+	if len(param.Mix) > 0 {
+		if err := api.validateMix(param.Mix); err != nil {
+			return err
+		}
+	} else if err := api.validatePayload(&param.Payload); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (api *Api) validateProtocol(protocol string) error {
+	if protocol != "bl2" && protocol != "grpc" {
+		return errs.E(errs.Validation, fmt.Sprintf("invalid protocol: %s, must be either 'bl2' or 'grpc'", protocol))
+	}
+
+	return nil
+}
+
+// validateSendParam validates the parameters shared by every test on how
+// requests are sent and how the run is stored
+func (api *Api) validateSendParam(param *service.BaseParam) error {
+	if param.MaxInFlight < 0 {
+		return errs.E(errs.Validation, "max_in_flight must not be negative")
+	}
+
+	if param.InFlightPolicy != "" && param.InFlightPolicy != service.InFlightPolicyBlock && param.InFlightPolicy != service.InFlightPolicyDrop {
+		return errs.E(errs.Validation, fmt.Sprintf("invalid in_flight_policy: %s, must be either 'block' or 'drop'", param.InFlightPolicy))
+	}
+
+	if err := api.validateGRPCClient(param.GRPCClient); err != nil {
+		return err
+	}
+
+	if err := api.validateTags(param.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateGRPCClient validates the optional gRPC client settings
+func (api *Api) validateGRPCClient(param *service.GRPCClient) error {
+	if param == nil {
+		return nil
+	}
+
+	if param.SubConnections < 0 {
+		return errs.E(errs.Validation, "grpc_client.sub_connections must not be negative")
+	}
+
+	switch param.LoadBalancingPolicy {
+	case "", go_gateway_adapter.LoadBalancingPickFirst, go_gateway_adapter.LoadBalancingRoundRobin:
+	default:
+		return errs.E(errs.Validation, fmt.Sprintf("invalid grpc_client.load_balancing_policy: %s, must be either 'pick_first' or 'round_robin'", param.LoadBalancingPolicy))
+	}
+
+	if param.InitialWindowSize < 0 || param.InitialConnWindowSize < 0 {
+		return errs.E(errs.Validation, "grpc_client window sizes must not be negative")
+	}
+
+	if param.MaxConcurrentStreams < 0 {
+		return errs.E(errs.Validation, "grpc_client.max_concurrent_streams must not be negative")
+	}
+
+	switch param.Compression {
+	case "", go_gateway_adapter.CompressionNone, go_gateway_adapter.CompressionGzip:
+	default:
+		return errs.E(errs.Validation, fmt.Sprintf("invalid grpc_client.compression: %s, must be either 'gzip' or 'none'", param.Compression))
+	}
+
+	return nil
+}
+
+// validateTags validates run tags, which are searched as a comma-separated list
+func (api *Api) validateTags(tags []string) error {
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) == "" || strings.Contains(tag, ",") {
+			return errs.E(errs.Validation, fmt.Sprintf("invalid tag: %q, tags must not be empty or contain commas", tag))
+		}
+	}
+
+	return nil
+}
+
+// validateMix validates a weighted operation mix. Operation payloads are
+// checked by the service against the operation registry.
+func (api *Api) validateMix(mix []service.OperationMix) error {
+	for i, entry := range mix {
+		if entry.Operation == "" {
+			return errs.E(errs.Validation, fmt.Sprintf("mix[%d].operation is required", i))
+		}
+
+		if entry.Weight <= 0 {
+			return errs.E(errs.Validation, fmt.Sprintf("mix[%d].weight must be greater than 0", i))
+		}
+	}
+
 	return nil
 }
 
@@ -95,5 +205,106 @@ func (api *Api) validateLoadRpsParam(param *service.LoadRpsParam) error {
 		return errs.E(errs.Validation, "total_reqs must be greater than 0")
 	}
 
+	if err := api.validateArrivalParam(&param.ArrivalParam); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateArrivalParam validates the arrival process of rate-based tests
+func (api *Api) validateArrivalParam(param *service.ArrivalParam) error {
+	switch param.Arrival {
+	case "", service.ArrivalTokenBucket, service.ArrivalConstant, service.ArrivalPoisson, service.ArrivalUniform:
+	default:
+		return errs.E(errs.Validation, fmt.Sprintf("invalid arrival: %s, must be one of 'token_bucket', 'constant', 'poisson' or 'uniform'", param.Arrival))
+	}
+
+	if param.Burst < 0 {
+		return errs.E(errs.Validation, "burst must not be negative")
+	}
+
+	return nil
+}
+
+// validateLoadSpikeParam validates spike-specific parameters
+func (api *Api) validateLoadSpikeParam(param *service.LoadSpikeParam) error {
+	if err := api.validateBaseParam(&param.BaseParam); err != nil {
+		return err
+	}
+
+	if param.BaselineRPS <= 0 {
+		return errs.E(errs.Validation, "baseline_rps must be greater than 0")
+	}
+
+	if param.SpikeRPS <= 0 {
+		return errs.E(errs.Validation, "spike_rps must be greater than 0")
+	}
+
+	if param.Tolerance < 0 || param.ErrorRateTolerance < 0 {
+		return errs.E(errs.Validation, "tolerance and error_rate_tolerance must not be negative")
+	}
+
+	if err := api.validateArrivalParam(&param.ArrivalParam); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLoadSearchParam validates capacity search parameters
+func (api *Api) validateLoadSearchParam(param *service.LoadSearchParam) error {
+	if err := api.validateBaseParam(&param.BaseParam); err != nil {
+		return err
+	}
+
+	if param.MinRPS < 0 || param.MaxRPS < 0 {
+		return errs.E(errs.Validation, "min_rps and max_rps must not be negative")
+	}
+
+	if param.MaxRPS > 0 && param.MinRPS > param.MaxRPS {
+		return errs.E(errs.Validation, "min_rps must not be greater than max_rps")
+	}
+
+	if param.SLOP99Ms <= 0 {
+		return errs.E(errs.Validation, "slo_p99_ms must be greater than 0")
+	}
+
+	if param.SLOErrorRate < 0 || param.SLOErrorRate > 1 {
+		return errs.E(errs.Validation, "slo_error_rate must be between 0 and 1")
+	}
+
+	if err := api.validateArrivalParam(&param.ArrivalParam); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLoadReplayParam validates replay parameters. service_name and
+// protocol are optional filters, the payload comes from the records.
+func (api *Api) validateLoadReplayParam(param *service.LoadReplayParam) error {
+	if param.File == "" && len(param.Records) == 0 {
+		return errs.E(errs.Validation, "file or records is required")
+	}
+
+	if param.Protocol != "" {
+		if err := api.validateProtocol(param.Protocol); err != nil {
+			return err
+		}
+	}
+
+	if err := api.validateSendParam(&param.BaseParam); err != nil {
+		return err
+	}
+
+	if param.Speed < 0 {
+		return errs.E(errs.Validation, "speed must not be negative")
+	}
+
+	if param.Loops < 0 {
+		return errs.E(errs.Validation, "loops must not be negative")
+	}
+
 	return nil
 }
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"load-tester/service"
+	"load-tester/util/errs"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func (api *Api) listRuns(c *fiber.Ctx) error {
+	// Parse request queries
+	param := service.ListRunsParam{
+		Mode:        c.Query("mode"),
+		ServiceName: c.Query("service_name"),
+		Protocol:    c.Query("protocol"),
+		Profile:     c.Query("profile"),
+		Query:       c.Query("q"),
+	}
+	if tags := c.Query("tags"); tags != "" {
+		param.Tags = strings.Split(tags, ",")
+	}
+
+	// Call service layer
+	result, err := api.service.ListRuns(context.Background(), &param)
+	if err != nil {
+		return api.runError(c, err)
+	}
+
+	return c.JSON(map[string]any{
+		"runs": result,
+	})
+}
+
+func (api *Api) getRun(c *fiber.Ctx) error {
+	// Call service layer
+	result, err := api.service.GetRun(context.Background(), &service.GetRunParam{
+		ID: c.Params("id"),
+	})
+	if err != nil {
+		return api.runError(c, err)
+	}
+
+	return c.JSON(result)
+}
+
+func (api *Api) setBaseline(c *fiber.Ctx) error {
+	// Call service layer
+	result, err := api.service.SetBaseline(context.Background(), &service.SetBaselineParam{
+		ID: c.Params("id"),
+	})
+	if err != nil {
+		return api.runError(c, err)
+	}
+
+	return c.JSON(result)
+}
+
+func (api *Api) compareRun(c *fiber.Ctx) error {
+	// Parse request queries
+	param := service.CompareRunParam{
+		CompareParam: service.CompareParam{
+			Alpha:               c.QueryFloat("alpha"),
+			LatencyTolerance:    c.QueryFloat("latency_tolerance"),
+			ThroughputTolerance: c.QueryFloat("throughput_tolerance"),
+		},
+		ID:         c.Params("id"),
+		BaselineID: c.Query("baseline_id"),
+	}
+
+	// Call service layer
+	result, err := api.service.CompareRun(context.Background(), &param)
+	if err != nil {
+		return api.runError(c, err)
+	}
+
+	return c.JSON(result)
+}
+
+// runError maps service errors of the run endpoints to a response
+func (api *Api) runError(c *fiber.Ctx, err error) error {
+	code := fiber.StatusInternalServerError
+	statusCode := errs.CODE_ERR_UNANTICIPATED
+
+	switch {
+	case errs.KindIs(errs.NotExist, err):
+		code = fiber.StatusNotFound
+		statusCode = errs.CODE_ERR_NOT_EXIST
+	case errs.KindIs(errs.Validation, err):
+		code = fiber.StatusBadRequest
+		statusCode = errs.CODE_ERR_VALIDATION
+	case errs.KindIs(errs.IO, err):
+		statusCode = errs.CODE_ERR_IO
+	}
+
+	return c.Status(code).JSON(map[string]any{
+		"remark":         "operation failed",
+		"status_code":    statusCode,
+		"status_message": err.Error(),
+	})
+}
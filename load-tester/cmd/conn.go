@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 
+	"load-tester/adapter/docker_adapter"
 	"load-tester/adapter/go_gateway_adapter"
 	"load-tester/adapter/py_gateway_adapter"
 	"load-tester/util/config"
@@ -19,6 +20,15 @@ func createPyGatewayAdapter(logger *logrus.Logger, serviceConfig config.Service)
 	return pyGatewayAdapter
 }
 
+// createDockerAdapter returns nil when per-service sampling is disabled
+func createDockerAdapter(logger *logrus.Logger, monitorConfig config.ResourceMonitor) *docker_adapter.Adapter {
+	if monitorConfig.DockerSocket == "" {
+		return nil
+	}
+
+	return docker_adapter.NewAdapter(logger, monitorConfig.DockerSocket)
+}
+
 func createGoGatewayAdapter(logger *logrus.Logger, tracer trace.Tracer, serviceConfig config.Service) (*go_gateway_adapter.Adapter, *grpc.ClientConn, error) {
 	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", serviceConfig.Host, serviceConfig.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"load-tester/api"
 	"load-tester/service"
+	"load-tester/store/file_store"
 	"load-tester/util/config"
 	"load-tester/util/errs"
 	"load-tester/util/tracing"
@@ -79,8 +80,14 @@ func start() {
 	}
 	defer conn.Close()
 
+	// init docker client for per-service resource samples
+	dockerAdapter := createDockerAdapter(logger, config.ResourceMonitor)
+
+	// init store layer
+	fileStore := file_store.NewStore(logger, config.Store.File.Dir)
+
 	// init service layer
-	service := service.NewService(logger, goGatewayAdapter, pyGatewayAdapter)
+	service := service.NewService(logger, config, goGatewayAdapter, pyGatewayAdapter, dockerAdapter, fileStore)
 
 	// init api layer
 	restApi := api.NewApi(logger, service)
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"load-tester/util/errs"
+
+	"golang.org/x/time/rate"
+)
+
+// Arrival processes for rate-based tests
+const (
+	ArrivalTokenBucket = "token_bucket" // Token bucket limiter, bursts up to the configured burst size
+	ArrivalConstant    = "constant"     // Evenly spaced arrivals
+	ArrivalPoisson     = "poisson"      // Exponentially distributed inter-arrival times
+	ArrivalUniform     = "uniform"      // Uniformly random inter-arrival times in [0, 2/rps)
+)
+
+// ArrivalParam selects how request arrivals are spaced in rate-based tests
+type ArrivalParam struct {
+	Arrival     string `json:"arrival"`      // "token_bucket" (default), "constant", "poisson" or "uniform"
+	Burst       int    `json:"burst"`        // Token bucket burst size, defaults to rps
+	ArrivalSeed *int64 `json:"arrival_seed"` // Seed for random arrival processes, defaults to the test seed
+}
+
+// arrivalProcess paces the generator of a rate-based test
+type arrivalProcess interface {
+	// wait blocks until the next request is due
+	wait(ctx context.Context) error
+	// setRate changes the average rate for the following arrivals
+	setRate(rps float64)
+}
+
+// newArrivalProcess creates the arrival process selected by param for the
+// given rate. Random processes use the arrival seed of param if set, seed otherwise.
+func newArrivalProcess(param *ArrivalParam, seed int64, rps float64) (arrivalProcess, error) {
+	const op errs.Op = "service/newArrivalProcess"
+
+	if rps <= 0 {
+		return nil, errs.E(op, errs.Validation, "rps must be greater than 0")
+	}
+
+	if param.ArrivalSeed != nil {
+		seed = *param.ArrivalSeed
+	}
+	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
+
+	switch param.Arrival {
+	case "", ArrivalTokenBucket:
+		burst := param.Burst
+		if burst <= 0 {
+			burst = int(rps)
+		}
+		if burst <= 0 {
+			burst = 1
+		}
+		return &tokenBucketArrivals{
+			limiter:     rate.NewLimiter(rate.Limit(rps), burst),
+			burstIsRate: param.Burst <= 0,
+		}, nil
+
+	case ArrivalConstant:
+		return newScheduledArrivals(rps, func(rps float64) time.Duration {
+			return time.Duration(float64(time.Second) / rps)
+		}), nil
+
+	case ArrivalPoisson:
+		return newScheduledArrivals(rps, func(rps float64) time.Duration {
+			return time.Duration(rng.ExpFloat64() / rps * float64(time.Second))
+		}), nil
+
+	case ArrivalUniform:
+		return newScheduledArrivals(rps, func(rps float64) time.Duration {
+			return time.Duration(rng.Float64() * 2 / rps * float64(time.Second))
+		}), nil
+
+	default:
+		return nil, errs.E(op, errs.Validation, fmt.Sprintf("invalid arrival: %s", param.Arrival))
+	}
+}
+
+// tokenBucketArrivals paces requests with a token bucket limiter
+type tokenBucketArrivals struct {
+	limiter *rate.Limiter
+
+	// burstIsRate keeps the burst size equal to the rate when no burst was configured
+	burstIsRate bool
+}
+
+func (arrivals *tokenBucketArrivals) wait(ctx context.Context) error {
+	return arrivals.limiter.Wait(ctx)
+}
+
+func (arrivals *tokenBucketArrivals) setRate(rps float64) {
+	arrivals.limiter.SetLimit(rate.Limit(rps))
+	if arrivals.burstIsRate {
+		arrivals.limiter.SetBurst(max(int(rps), 1))
+	}
+}
+
+// scheduledArrivals releases requests at absolute times built from a sequence
+// of inter-arrival gaps, so sleep overshoot does not accumulate as drift
+type scheduledArrivals struct {
+	rps  float64
+	gap  func(rps float64) time.Duration
+	next time.Time
+}
+
+func newScheduledArrivals(rps float64, gap func(rps float64) time.Duration) *scheduledArrivals {
+	return &scheduledArrivals{rps: rps, gap: gap}
+}
+
+func (arrivals *scheduledArrivals) setRate(rps float64) {
+	arrivals.rps = rps
+}
+
+func (arrivals *scheduledArrivals) wait(ctx context.Context) error {
+	if arrivals.next.IsZero() {
+		// First request goes out immediately
+		arrivals.next = time.Now().Add(arrivals.gap(arrivals.rps))
+		return ctx.Err()
+	}
+
+	due := arrivals.next
+	arrivals.next = due.Add(arrivals.gap(arrivals.rps))
+
+	return sleepUntil(ctx, due)
+}
+
+// sleepUntil blocks until due or until ctx is done
+func sleepUntil(ctx context.Context, due time.Time) error {
+	delay := time.Until(due)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
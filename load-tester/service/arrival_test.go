@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewArrivalProcess(t *testing.T) {
+	tests := map[string]struct {
+		arrival string
+		rps     float64
+		wantErr bool
+	}{
+		"default":      {arrival: "", rps: 10},
+		"token bucket": {arrival: ArrivalTokenBucket, rps: 10},
+		"constant":     {arrival: ArrivalConstant, rps: 10},
+		"poisson":      {arrival: ArrivalPoisson, rps: 10},
+		"uniform":      {arrival: ArrivalUniform, rps: 10},
+		"unknown":      {arrival: "bursty", rps: 10, wantErr: true},
+		"zero rate":    {arrival: ArrivalConstant, rps: 0, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newArrivalProcess(&ArrivalParam{Arrival: tt.arrival}, 1, tt.rps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScheduledArrivalGaps(t *testing.T) {
+	const (
+		rps     = 200.0
+		samples = 20000
+	)
+	mean := time.Duration(float64(time.Second) / rps)
+
+	tests := map[string]struct {
+		arrival string
+		maxGap  time.Duration // Upper bound of a single gap, 0 if unbounded
+	}{
+		"constant": {arrival: ArrivalConstant, maxGap: mean},
+		"poisson":  {arrival: ArrivalPoisson},
+		"uniform":  {arrival: ArrivalUniform, maxGap: 2 * mean},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			process, err := newArrivalProcess(&ArrivalParam{Arrival: tt.arrival}, 1, rps)
+			if err != nil {
+				t.Fatalf("newArrivalProcess: %v", err)
+			}
+			arrivals := process.(*scheduledArrivals)
+
+			var total time.Duration
+			for i := 0; i < samples; i++ {
+				gap := arrivals.gap(arrivals.rps)
+				if gap < 0 || (tt.maxGap > 0 && gap > tt.maxGap) {
+					t.Fatalf("gap %s outside [0, %s]", gap, tt.maxGap)
+				}
+				total += gap
+			}
+
+			got := total / samples
+			if diff := math.Abs(float64(got-mean)) / float64(mean); diff > 0.03 {
+				t.Fatalf("mean gap = %s, want %s within 3%%", got, mean)
+			}
+		})
+	}
+}
+
+func TestScheduledArrivalsSeed(t *testing.T) {
+	gaps := func(seed int64) []time.Duration {
+		process, err := newArrivalProcess(&ArrivalParam{Arrival: ArrivalPoisson}, seed, 100)
+		if err != nil {
+			t.Fatalf("newArrivalProcess: %v", err)
+		}
+		arrivals := process.(*scheduledArrivals)
+
+		var gaps []time.Duration
+		for i := 0; i < 10; i++ {
+			gaps = append(gaps, arrivals.gap(arrivals.rps))
+		}
+		return gaps
+	}
+
+	a, b, c := gaps(7), gaps(7), gaps(8)
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed gave different gaps: %v and %v", a, b)
+		}
+	}
+
+	same := true
+	for i := range a {
+		same = same && a[i] == c[i]
+	}
+	if same {
+		t.Fatal("different seeds gave the same gaps")
+	}
+}
+
+func TestScheduledArrivalsArrivalSeed(t *testing.T) {
+	seed := int64(7)
+	first, err := newArrivalProcess(&ArrivalParam{Arrival: ArrivalUniform, ArrivalSeed: &seed}, 1, 100)
+	if err != nil {
+		t.Fatalf("newArrivalProcess: %v", err)
+	}
+	second, err := newArrivalProcess(&ArrivalParam{Arrival: ArrivalUniform}, 7, 100)
+	if err != nil {
+		t.Fatalf("newArrivalProcess: %v", err)
+	}
+
+	a, b := first.(*scheduledArrivals), second.(*scheduledArrivals)
+	for i := 0; i < 10; i++ {
+		if a.gap(a.rps) != b.gap(b.rps) {
+			t.Fatal("arrival_seed did not replace the test seed")
+		}
+	}
+}
+
+func TestScheduledArrivalsWait(t *testing.T) {
+	arrivals := newScheduledArrivals(100, func(rps float64) time.Duration {
+		return time.Duration(float64(time.Second) / rps)
+	})
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if err := arrivals.wait(context.Background()); err != nil {
+			t.Fatalf("wait: %v", err)
+		}
+	}
+
+	// The first request goes out immediately, then one every 10ms
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("6 arrivals took %s, want at least 50ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := arrivals.wait(ctx); err == nil {
+		t.Fatal("wait ignored a canceled context")
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"math"
+
+	"load-tester/store/file_store"
+	"load-tester/util/stats"
+)
+
+// Comparison verdicts
+const (
+	VerdictRegressed  = "regressed"
+	VerdictImproved   = "improved"
+	VerdictEquivalent = "equivalent"
+)
+
+const (
+	defaultCompareAlpha               = 0.05
+	defaultCompareLatencyTolerance    = 0.05
+	defaultCompareThroughputTolerance = 0.05
+
+	bootstrapResamples = 500
+	bootstrapMaxSample = 1000 // Latencies per run fed to the bootstrap
+)
+
+// CompareParam tunes how strict a run comparison is
+type CompareParam struct {
+	Alpha               float64 `json:"alpha"`                // Significance level, defaults to 0.05
+	LatencyTolerance    float64 `json:"latency_tolerance"`    // Relative latency change ignored, defaults to 0.05
+	ThroughputTolerance float64 `json:"throughput_tolerance"` // Relative throughput band, defaults to 0.05
+}
+
+// MetricComparison is the verdict for a single metric. PValue is set for
+// metrics compared by a significance test, Support for bootstrapped metrics
+// (the share of resamples that agree with the verdict); both are left out for
+// metrics compared by a plain tolerance band.
+type MetricComparison struct {
+	Metric   string   `json:"metric"`
+	Verdict  string   `json:"verdict"`
+	Baseline float64  `json:"baseline"`
+	Current  float64  `json:"current"`
+	Change   float64  `json:"change"` // Relative change, absolute for error_rate
+	Method   string   `json:"method"`
+	PValue   *float64 `json:"p_value,omitempty"`
+	Support  *float64 `json:"support,omitempty"`
+}
+
+// Comparison reports how a run differs from its baseline
+type Comparison struct {
+	Key           string             `json:"key"`
+	RunID         string             `json:"run_id"`
+	BaselineRunID string             `json:"baseline_run_id"`
+	Regressed     []string           `json:"regressed"`
+	Improved      []string           `json:"improved"`
+	Equivalent    []string           `json:"equivalent"`
+	Metrics       []MetricComparison `json:"metrics"`
+}
+
+// withDefaults fills unset thresholds
+func (param CompareParam) withDefaults() CompareParam {
+	if param.Alpha <= 0 || param.Alpha >= 1 {
+		param.Alpha = defaultCompareAlpha
+	}
+	if param.LatencyTolerance <= 0 {
+		param.LatencyTolerance = defaultCompareLatencyTolerance
+	}
+	if param.ThroughputTolerance <= 0 {
+		param.ThroughputTolerance = defaultCompareThroughputTolerance
+	}
+
+	return param
+}
+
+// compareRuns compares run against baseline
+func compareRuns(baseline, run *file_store.Run, param CompareParam) *Comparison {
+	param = param.withDefaults()
+
+	comparison := &Comparison{
+		Key:           run.Key(),
+		RunID:         run.ID,
+		BaselineRunID: baseline.ID,
+		Regressed:     []string{},
+		Improved:      []string{},
+		Equivalent:    []string{},
+	}
+
+	base, curr := baseline.Stats.LatencySampleMs, run.Stats.LatencySampleMs
+
+	comparison.add(compareLatencyDistribution(base, curr, param))
+	comparison.add(compareLatencyPercentile("p95_latency_ms", 95, base, curr, param))
+	comparison.add(compareLatencyPercentile("p99_latency_ms", 99, base, curr, param))
+	comparison.add(compareErrorRate(&baseline.Stats, &run.Stats, param))
+	comparison.add(compareThroughput(baseline.Stats.AverageRPS, run.Stats.AverageRPS, param))
+
+	return comparison
+}
+
+func (comparison *Comparison) add(metric MetricComparison) {
+	comparison.Metrics = append(comparison.Metrics, metric)
+
+	switch metric.Verdict {
+	case VerdictRegressed:
+		comparison.Regressed = append(comparison.Regressed, metric.Metric)
+	case VerdictImproved:
+		comparison.Improved = append(comparison.Improved, metric.Metric)
+	default:
+		comparison.Equivalent = append(comparison.Equivalent, metric.Metric)
+	}
+}
+
+// compareLatencyDistribution runs a Mann-Whitney U test on the latency samples.
+// A significant shift only counts when the median moved beyond the tolerance.
+func compareLatencyDistribution(base, curr []float64, param CompareParam) MetricComparison {
+	baseMedian, currMedian := stats.Median(base), stats.Median(curr)
+	_, _, p := stats.MannWhitneyU(base, curr)
+	change := relativeChange(baseMedian, currMedian)
+
+	verdict := VerdictEquivalent
+	if p < param.Alpha && math.Abs(change) > param.LatencyTolerance {
+		if change > 0 {
+			verdict = VerdictRegressed
+		} else {
+			verdict = VerdictImproved
+		}
+	}
+
+	return MetricComparison{
+		Metric:   "median_latency_ms",
+		Verdict:  verdict,
+		Baseline: baseMedian,
+		Current:  currMedian,
+		Change:   change,
+		Method:   "mann_whitney_u",
+		PValue:   &p,
+	}
+}
+
+// compareLatencyPercentile bootstraps a latency percentile of both samples.
+// The resampling runs on a random subsample of each sample to bound its cost,
+// as it runs at the end of every test.
+func compareLatencyPercentile(name string, percentile float64, base, curr []float64, param CompareParam) MetricComparison {
+	baseValue := stats.Percentile(append([]float64(nil), base...), percentile)
+	currValue := stats.Percentile(append([]float64(nil), curr...), percentile)
+
+	above, below := stats.BootstrapPercentileDiff(stats.Sample(base, bootstrapMaxSample, 1), stats.Sample(curr, bootstrapMaxSample, 1),
+		percentile, param.LatencyTolerance, bootstrapResamples, 1)
+
+	verdict := VerdictEquivalent
+	support := 1 - above - below
+	switch {
+	case above >= 1-param.Alpha:
+		verdict = VerdictRegressed
+		support = above
+	case below >= 1-param.Alpha:
+		verdict = VerdictImproved
+		support = below
+	}
+
+	return MetricComparison{
+		Metric:   name,
+		Verdict:  verdict,
+		Baseline: baseValue,
+		Current:  currValue,
+		Change:   relativeChange(baseValue, currValue),
+		Method:   "bootstrap",
+		Support:  &support,
+	}
+}
+
+// compareErrorRate runs a two-proportion z-test on the failure counts
+func compareErrorRate(base, curr *file_store.RunStats, param CompareParam) MetricComparison {
+	baseRate, currRate := proportion(base.FailedRequests, base.TotalRequests), proportion(curr.FailedRequests, curr.TotalRequests)
+	z, p := stats.TwoProportionZ(base.FailedRequests, base.TotalRequests, curr.FailedRequests, curr.TotalRequests)
+
+	verdict := VerdictEquivalent
+	if p < param.Alpha {
+		if z > 0 {
+			verdict = VerdictRegressed
+		} else {
+			verdict = VerdictImproved
+		}
+	}
+
+	return MetricComparison{
+		Metric:   "error_rate",
+		Verdict:  verdict,
+		Baseline: baseRate,
+		Current:  currRate,
+		Change:   currRate - baseRate,
+		Method:   "two_proportion_z",
+		PValue:   &p,
+	}
+}
+
+// compareThroughput checks the average RPS against a tolerance band
+func compareThroughput(base, curr float64, param CompareParam) MetricComparison {
+	change := relativeChange(base, curr)
+
+	verdict := VerdictEquivalent
+	switch {
+	case change < -param.ThroughputTolerance:
+		verdict = VerdictRegressed
+	case change > param.ThroughputTolerance:
+		verdict = VerdictImproved
+	}
+
+	return MetricComparison{
+		Metric:   "average_rps",
+		Verdict:  verdict,
+		Baseline: base,
+		Current:  curr,
+		Change:   change,
+		Method:   "tolerance_band",
+	}
+}
+
+func relativeChange(base, curr float64) float64 {
+	if base == 0 {
+		return 0
+	}
+
+	return (curr - base) / base
+}
+
+func proportion(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(part) / float64(total)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// In-flight policies applied when max_in_flight is reached
+const (
+	InFlightPolicyBlock = "block" // Block the generator until a slot frees up
+	InFlightPolicyDrop  = "drop"  // Count the request as dropped by the client
+)
+
+// dispatcher launches requests in their own goroutine, optionally bounded by
+// a maximum number of requests in flight. With an operation mix the recorder
+// also keeps a breakdown per operation.
+type dispatcher struct {
+	service *Service
+	session *session
+	param   *BaseParam
+	rec     *recorder
+
+	// execute sends a request, through the session adapters unless replaced in tests
+	execute func(ctx context.Context, req *request) error
+
+	// slots is nil when the number of in-flight requests is unbounded
+	slots  chan struct{}
+	policy string
+
+	wg sync.WaitGroup
+}
+
+func newDispatcher(service *Service, session *session, param *BaseParam, rec *recorder) *dispatcher {
+	d := &dispatcher{
+		service: service,
+		session: session,
+		param:   param,
+		rec:     rec,
+		policy:  param.InFlightPolicy,
+	}
+
+	d.execute = func(ctx context.Context, req *request) error {
+		return service.executeRequest(ctx, session.adapter, req)
+	}
+
+	if d.policy == "" {
+		d.policy = InFlightPolicyBlock
+	}
+
+	if param.MaxInFlight > 0 {
+		d.slots = make(chan struct{}, param.MaxInFlight)
+	}
+
+	if session.builder.mixed {
+		rec.enableOperations()
+	}
+
+	return d
+}
+
+// dispatch launches the next request of the session. With the block policy it waits for a
+// free slot and returns false if ctx is done first; with the drop policy a
+// request that finds no free slot is recorded as a client-side drop.
+func (d *dispatcher) dispatch(ctx context.Context, isWarmup bool) bool {
+	return d.dispatchRequest(ctx, isWarmup, d.session.builder.next())
+}
+
+// dispatchRequest launches req, applying the in-flight policy like dispatch
+func (d *dispatcher) dispatchRequest(ctx context.Context, isWarmup bool, req *request) bool {
+	if d.slots != nil {
+		switch d.policy {
+		case InFlightPolicyDrop:
+			select {
+			case d.slots <- struct{}{}:
+			default:
+				d.rec.recordClientDrop(isWarmup)
+				return true
+			}
+		default:
+			select {
+			case d.slots <- struct{}{}:
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		if d.slots != nil {
+			defer func() { <-d.slots }()
+		}
+
+		start := time.Now()
+		err := d.execute(ctx, req)
+		d.rec.record(isWarmup, req.operation, start, time.Since(start), err)
+	}()
+
+	return true
+}
+
+// wait blocks until every dispatched request has completed
+func (d *dispatcher) wait() {
+	d.wg.Wait()
+}
+
+// dispatchFor sends requests paced by arrivals until duration has elapsed.
+// It returns false if ctx ended before that.
+func (d *dispatcher) dispatchFor(ctx context.Context, arrivals arrivalProcess, duration time.Duration) bool {
+	deadline := time.Now().Add(duration)
+
+	for {
+		if err := arrivals.wait(ctx); err != nil {
+			return false
+		}
+		if !time.Now().Before(deadline) {
+			return true
+		}
+		if !d.dispatch(ctx, false) {
+			return false
+		}
+	}
+}
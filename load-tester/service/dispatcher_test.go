@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestDispatcher returns a dispatcher whose requests block until release
+// is closed, counting the requests that reached execute
+func newTestDispatcher(t *testing.T, param *BaseParam, release chan struct{}, executed *atomic.Int64) *dispatcher {
+	t.Helper()
+
+	param.Protocol = "grpc"
+	param.Payload.AccountNumber = "1001000000001"
+
+	builder, err := newRequestBuilder(param, 1)
+	if err != nil {
+		t.Fatalf("newRequestBuilder: %v", err)
+	}
+
+	d := newDispatcher(&Service{}, &session{builder: builder}, param, newRecorder("grpc", 0, &warmupPhase{}))
+	d.execute = func(ctx context.Context, req *request) error {
+		executed.Add(1)
+		<-release
+		return nil
+	}
+
+	return d
+}
+
+func TestDispatcherDropPolicy(t *testing.T) {
+	release := make(chan struct{})
+	var executed atomic.Int64
+	d := newTestDispatcher(t, &BaseParam{MaxInFlight: 2, InFlightPolicy: InFlightPolicyDrop}, release, &executed)
+
+	for i := 0; i < 5; i++ {
+		if !d.dispatch(context.Background(), false) {
+			t.Fatalf("dispatch %d stopped the generator", i)
+		}
+	}
+
+	close(release)
+	d.wait()
+
+	if got := executed.Load(); got != 2 {
+		t.Fatalf("executed = %d, want 2", got)
+	}
+	if got := d.rec.measured.ClientDropped.Load(); got != 3 {
+		t.Fatalf("client dropped = %d, want 3", got)
+	}
+	if got := d.rec.measured.TotalRequests.Load(); got != 2 {
+		t.Fatalf("total requests = %d, want 2", got)
+	}
+}
+
+func TestDispatcherBlockPolicy(t *testing.T) {
+	release := make(chan struct{})
+	var executed atomic.Int64
+	d := newTestDispatcher(t, &BaseParam{MaxInFlight: 1}, release, &executed)
+
+	if !d.dispatch(context.Background(), false) {
+		t.Fatal("first dispatch stopped the generator")
+	}
+
+	// The second request waits for the slot and gives up with ctx
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if d.dispatch(ctx, false) {
+		t.Fatal("dispatch did not block on the full in-flight limit")
+	}
+
+	close(release)
+	d.wait()
+
+	if got := executed.Load(); got != 1 {
+		t.Fatalf("executed = %d, want 1", got)
+	}
+	if got := d.rec.measured.ClientDropped.Load(); got != 0 {
+		t.Fatalf("client dropped = %d, want 0", got)
+	}
+
+	// A freed slot lets the generator continue
+	if !d.dispatch(context.Background(), false) {
+		t.Fatal("dispatch blocked with a free slot")
+	}
+	d.wait()
+}
+
+func TestDispatcherUnbounded(t *testing.T) {
+	release := make(chan struct{})
+	var executed atomic.Int64
+	d := newTestDispatcher(t, &BaseParam{}, release, &executed)
+
+	for i := 0; i < 10; i++ {
+		if !d.dispatch(context.Background(), false) {
+			t.Fatalf("dispatch %d stopped the generator", i)
+		}
+	}
+
+	close(release)
+	d.wait()
+
+	if got := executed.Load(); got != 10 {
+		t.Fatalf("executed = %d, want 10", got)
+	}
+}
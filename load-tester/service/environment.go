@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"load-tester/util/config"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// targetInfoTimeout bounds the request for version info of a target
+	targetInfoTimeout = 2 * time.Second
+
+	// maxTargetInfoSize bounds the version info stored for a target
+	maxTargetInfoSize = 64 * 1024
+
+	// unlimitedMemory is the cgroup v1 memory limit reported without a limit
+	unlimitedMemory = 1 << 62
+)
+
+// Environment describes the machine, build and configuration a run was
+// produced with
+type Environment struct {
+	Hostname         string         `json:"hostname"`
+	OS               string         `json:"os"`
+	Arch             string         `json:"arch"`
+	GoVersion        string         `json:"go_version"`
+	GOMAXPROCS       int            `json:"gomaxprocs"`
+	NumCPU           int            `json:"num_cpu"`
+	CPUModel         string         `json:"cpu_model,omitempty"`
+	CPULimit         float64        `json:"cpu_limit,omitempty"`          // Container CPU limit in cores, unset without a limit
+	MemoryLimitBytes int64          `json:"memory_limit_bytes,omitempty"` // Container memory limit, unset without a limit
+	Config           config.Config  `json:"config"`                       // Load tester config
+	Targets          map[string]any `json:"targets,omitempty"`            // Version info exposed by the targets
+}
+
+// captureEnvironment describes the current environment. Failures to read a
+// field leave it unset.
+func (service *Service) captureEnvironment(ctx context.Context) *Environment {
+	env := &Environment{
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		GoVersion:  runtime.Version(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		NumCPU:     runtime.NumCPU(),
+		Config:     service.config,
+	}
+
+	env.Hostname, _ = os.Hostname()
+
+	if infos, err := cpu.InfoWithContext(ctx); err == nil && len(infos) > 0 {
+		env.CPUModel = infos[0].ModelName
+	}
+
+	env.CPULimit = cgroupCPULimit()
+	env.MemoryLimitBytes = cgroupMemoryLimit()
+
+	for _, target := range []config.Service{
+		service.config.ExternalService.GoGateway,
+		service.config.ExternalService.PyGateway,
+	} {
+		if target.InfoURL == "" {
+			continue
+		}
+
+		info, err := fetchTargetInfo(ctx, target.InfoURL)
+		if err != nil {
+			service.logger.WithFields(logrus.Fields{
+				"op":     "service/captureEnvironment",
+				"target": target.Name,
+				"err":    err.Error(),
+			}).Warn("Failed to get target info")
+			continue
+		}
+
+		if env.Targets == nil {
+			env.Targets = make(map[string]any)
+		}
+		env.Targets[target.Name] = info
+	}
+
+	return env
+}
+
+// fetchTargetInfo gets the info endpoint of a target. JSON bodies are kept
+// as they are, other bodies as text.
+func fetchTargetInfo(ctx context.Context, url string) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, targetInfoTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTargetInfoSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var info any
+	if err := json.Unmarshal(body, &info); err != nil {
+		return strings.TrimSpace(string(body)), nil
+	}
+
+	return info, nil
+}
+
+// cgroupCPULimit returns the container CPU limit in cores, 0 without a limit
+func cgroupCPULimit() float64 {
+	// cgroup v2: "<quota> <period>" or "max <period>"
+	if fields := strings.Fields(readCgroupFile("/sys/fs/cgroup/cpu.max")); len(fields) == 2 {
+		quota, errQuota := strconv.ParseFloat(fields[0], 64)
+		period, errPeriod := strconv.ParseFloat(fields[1], 64)
+		if errQuota == nil && errPeriod == nil && period > 0 {
+			return quota / period
+		}
+		return 0
+	}
+
+	// cgroup v1, a quota of -1 means no limit
+	quota, errQuota := strconv.ParseFloat(readCgroupFile("/sys/fs/cgroup/cpu/cpu.cfs_quota_us"), 64)
+	period, errPeriod := strconv.ParseFloat(readCgroupFile("/sys/fs/cgroup/cpu/cpu.cfs_period_us"), 64)
+	if errQuota == nil && errPeriod == nil && quota > 0 && period > 0 {
+		return quota / period
+	}
+
+	return 0
+}
+
+// cgroupMemoryLimit returns the container memory limit in bytes, 0 without a limit
+func cgroupMemoryLimit() int64 {
+	for _, path := range []string{
+		"/sys/fs/cgroup/memory.max",                   // cgroup v2, "max" without a limit
+		"/sys/fs/cgroup/memory/memory.limit_in_bytes", // cgroup v1
+	} {
+		limit, err := strconv.ParseInt(readCgroupFile(path), 10, 64)
+		if err == nil && limit > 0 && limit < unlimitedMemory {
+			return limit
+		}
+	}
+
+	return 0
+}
+
+func readCgroupFile(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorCategory is the structured class a failed request is counted under
+type ErrorCategory string
+
+const (
+	ErrorCategoryTransport  ErrorCategory = "transport"    // Connection could not be made or was lost
+	ErrorCategoryTimeout    ErrorCategory = "timeout"      // Request timed out
+	ErrorCategoryDropped    ErrorCategory = "dropped"      // Request rejected because the target is overloaded
+	ErrorCategoryNotFound   ErrorCategory = "not_found"    // Requested item does not exist
+	ErrorCategoryValidation ErrorCategory = "validation"   // Request was rejected as invalid
+	ErrorCategoryServer     ErrorCategory = "server_error" // Target failed to process the request
+	ErrorCategoryAssertion  ErrorCategory = "assertion"    // Response did not have the expected shape
+	ErrorCategoryCanceled   ErrorCategory = "canceled"     // Request was cut short because the test stopped
+)
+
+// BL2 status codes returned in the "status" field of a response
+const (
+	bl2StatusSuccess        = "000"
+	bl2StatusConnectionLost = "996"
+	bl2StatusDropped        = "997"
+	bl2StatusTimeout        = "998"
+	bl2StatusError          = "999"
+)
+
+// maxErrorSamples is the number of sample messages kept per category
+const maxErrorSamples = 5
+
+// RequestError describes a failed request together with its category and
+// the protocol-level code that caused it
+type RequestError struct {
+	Category ErrorCategory
+	Protocol string // "bl2" or "grpc"
+	Code     string // gRPC code name or BL2 status
+	Message  string
+	Err      error
+}
+
+func (e *RequestError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%s: %s", e.Category, e.Message)
+	}
+
+	return fmt.Sprintf("%s (%s %s): %s", e.Category, e.Protocol, e.Code, e.Message)
+}
+
+// Unwrap returns the underlying error, if any
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// Is keeps errors.Is(err, ErrTimeout) and errors.Is(err, ErrDropped) working
+// for classified errors
+func (e *RequestError) Is(target error) bool {
+	switch target {
+	case ErrTimeout:
+		return e.Category == ErrorCategoryTimeout
+	case ErrDropped:
+		return e.Category == ErrorCategoryDropped
+	}
+
+	return false
+}
+
+// ErrorStat aggregates the failures of one category
+type ErrorStat struct {
+	Count   int64            `json:"count"`
+	Codes   map[string]int64 `json:"codes"`
+	Samples []string         `json:"samples"`
+}
+
+// ErrorBreakdown holds error statistics per category
+type ErrorBreakdown map[ErrorCategory]*ErrorStat
+
+// add records a classified error in the breakdown
+func (breakdown ErrorBreakdown) add(reqErr *RequestError) {
+	stat, ok := breakdown[reqErr.Category]
+	if !ok {
+		stat = &ErrorStat{
+			Codes:   make(map[string]int64),
+			Samples: make([]string, 0, maxErrorSamples),
+		}
+		breakdown[reqErr.Category] = stat
+	}
+
+	stat.Count++
+
+	if reqErr.Code != "" {
+		stat.Codes[reqErr.Code]++
+	}
+
+	if len(stat.Samples) < maxErrorSamples {
+		for _, sample := range stat.Samples {
+			if sample == reqErr.Message {
+				return
+			}
+		}
+		stat.Samples = append(stat.Samples, reqErr.Message)
+	}
+}
+
+// classifyError converts any error into a *RequestError. Errors that were
+// already classified are returned as they are.
+func classifyError(protocol string, err error) *RequestError {
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &RequestError{Category: ErrorCategoryTimeout, Protocol: protocol, Message: err.Error(), Err: err}
+	case errors.Is(err, context.Canceled):
+		return &RequestError{Category: ErrorCategoryCanceled, Protocol: protocol, Message: err.Error(), Err: err}
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return newGRPCError(grpcErr.GRPCStatus())
+	}
+
+	// Errors that never reached the protocol layer are transport failures
+	return &RequestError{Category: ErrorCategoryTransport, Protocol: protocol, Message: err.Error(), Err: err}
+}
+
+// newGRPCError classifies a gRPC status
+func newGRPCError(st *status.Status) *RequestError {
+	var category ErrorCategory
+
+	switch st.Code() {
+	case codes.DeadlineExceeded:
+		category = ErrorCategoryTimeout
+	case codes.Aborted, codes.ResourceExhausted:
+		category = ErrorCategoryDropped
+	case codes.NotFound:
+		category = ErrorCategoryNotFound
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		category = ErrorCategoryValidation
+	case codes.Unavailable:
+		category = ErrorCategoryTransport
+	case codes.Canceled:
+		category = ErrorCategoryCanceled
+	default:
+		category = ErrorCategoryServer
+	}
+
+	return &RequestError{
+		Category: category,
+		Protocol: "grpc",
+		Code:     st.Code().String(),
+		Message:  st.Message(),
+		Err:      st.Err(),
+	}
+}
+
+// newBL2Error classifies a BL2 response status and its err_info message
+func newBL2Error(statusCode, errInfo string) *RequestError {
+	var category ErrorCategory
+
+	switch statusCode {
+	case bl2StatusConnectionLost:
+		category = ErrorCategoryTransport
+	case bl2StatusDropped:
+		category = ErrorCategoryDropped
+	case bl2StatusTimeout:
+		category = ErrorCategoryTimeout
+	default:
+		// The Python stack reports every other failure as 999, so the
+		// message is the only hint left about what went wrong
+		lower := strings.ToLower(errInfo)
+		switch {
+		case errInfo == "timeout":
+			category = ErrorCategoryTimeout
+		case errInfo == "dropped":
+			category = ErrorCategoryDropped
+		case strings.Contains(lower, "not found"):
+			category = ErrorCategoryNotFound
+		case strings.HasPrefix(lower, "missing ") || strings.HasPrefix(lower, "unknown operation"):
+			category = ErrorCategoryValidation
+		default:
+			category = ErrorCategoryServer
+		}
+	}
+
+	return &RequestError{
+		Category: category,
+		Protocol: "bl2",
+		Code:     statusCode,
+		Message:  errInfo,
+	}
+}
+
+// newValidationError classifies a request that could not be built locally
+func newValidationError(protocol string, err error) *RequestError {
+	return &RequestError{Category: ErrorCategoryValidation, Protocol: protocol, Message: err.Error(), Err: err}
+}
+
+// newAssertionError classifies a response that did not match expectations
+func newAssertionError(protocol string, err error) *RequestError {
+	return &RequestError{Category: ErrorCategoryAssertion, Protocol: protocol, Message: err.Error(), Err: err}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want ErrorCategory
+	}{
+		"deadline":           {context.DeadlineExceeded, ErrorCategoryTimeout},
+		"canceled":           {fmt.Errorf("send: %w", context.Canceled), ErrorCategoryCanceled},
+		"grpc canceled":      {status.Error(codes.Canceled, "context canceled"), ErrorCategoryCanceled},
+		"grpc unavailable":   {status.Error(codes.Unavailable, "connection refused"), ErrorCategoryTransport},
+		"grpc not found":     {status.Error(codes.NotFound, "account not found"), ErrorCategoryNotFound},
+		"grpc internal":      {status.Error(codes.Internal, "boom"), ErrorCategoryServer},
+		"classified":         {newBL2Error(bl2StatusDropped, "dropped"), ErrorCategoryDropped},
+		"unclassified error": {errors.New("connection reset"), ErrorCategoryTransport},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := classifyError("grpc", tt.err).Category; got != tt.want {
+				t.Fatalf("category = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecorderDropsCanceledRequests(t *testing.T) {
+	rec := newRecorder("grpc", 3, &warmupPhase{})
+
+	rec.record(false, "", time.Now(), time.Millisecond, nil)
+	rec.record(false, "", time.Now(), time.Millisecond, errors.New("connection reset"))
+	rec.record(false, "", time.Now(), time.Millisecond, context.Canceled)
+
+	if got := rec.measured.TotalRequests.Load(); got != 2 {
+		t.Fatalf("total requests = %d, want 2", got)
+	}
+	if got := rec.measured.CanceledRequests.Load(); got != 1 {
+		t.Fatalf("canceled requests = %d, want 1", got)
+	}
+	if _, ok := rec.measured.Errors[ErrorCategoryCanceled]; ok {
+		t.Fatal("canceled request counted as an error")
+	}
+	if got := len(rec.measured.Latencies); got != 2 {
+		t.Fatalf("latencies = %d, want 2", got)
+	}
+}
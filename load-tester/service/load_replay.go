@@ -0,0 +1,333 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"load-tester/util/errs"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxReplayLineSize bounds a single JSONL record
+const maxReplayLineSize = 1024 * 1024
+
+type LoadReplayParam struct {
+	BaseParam
+	File    string         `json:"file"`    // JSONL request log, relative to the configured replay directory
+	Records []ReplayRecord `json:"records"` // Inline records, used when no file is given
+	Speed   float64        `json:"speed"`   // Replay speed multiplier, 2 replays twice as fast, defaults to 1
+	Loops   int            `json:"loops"`   // Number of passes over the records, defaults to 1
+}
+
+// ReplayRecord is a single recorded request. Timestamp is either an RFC 3339
+// string or unix seconds. For bl2 the payload may be a full BL2 message, in
+// which case its operation and params are used.
+type ReplayRecord struct {
+	Timestamp json.RawMessage `json:"timestamp"`
+	Target    string          `json:"target"`
+	Protocol  string          `json:"protocol"`
+	Operation string          `json:"operation"`
+	Payload   map[string]any  `json:"payload"`
+}
+
+type LoadReplayResult struct {
+	TestResult
+	Records int `json:"records"` // Records replayed per loop
+	Skipped int `json:"skipped"` // Records filtered out by service_name/protocol
+	Loops   int `json:"loops"`
+}
+
+// replayEntry is a record ready to be sent at offset from the start of a loop
+type replayEntry struct {
+	offset time.Duration
+	req    *request
+}
+
+// Traffic replay - reissues recorded requests keeping their original
+// inter-arrival gaps, divided by speed. service_name and protocol, when set,
+// only replay records of that target and protocol. Warmup requests are the
+// first replayed records.
+func (service *Service) LoadReplay(ctx context.Context, param *LoadReplayParam) (map[string]any, error) {
+	const op errs.Op = "service/LoadReplay"
+
+	service.logger.WithFields(logrus.Fields{
+		"op":      op,
+		"file":    param.File,
+		"records": len(param.Records),
+		"speed":   param.Speed,
+		"loops":   param.Loops,
+	}).Info("Starting replay load test")
+
+	warmup, err := newWarmupPhase(param.Warmup)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	// Prepare the adapters and the request mix
+	session, err := service.newSession(&param.BaseParam)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+	defer session.close()
+
+	records := param.Records
+	if param.File != "" {
+		records, err = readReplayRecords(service.config.Replay.Dir, param.File)
+		if err != nil {
+			return nil, errs.E(op, err)
+		}
+	}
+
+	gateways := map[string]string{
+		"bl2":  service.config.ExternalService.PyGateway.Name,
+		"grpc": service.config.ExternalService.GoGateway.Name,
+	}
+
+	entries, skipped, err := newReplayEntries(records, &param.BaseParam, gateways, param.Speed)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	loops := max(param.Loops, 1)
+
+	// The next loop starts one average gap after the last record
+	span := entries[len(entries)-1].offset
+	loopSpan := span
+	if len(entries) > 1 {
+		loopSpan += span / time.Duration(len(entries)-1)
+	}
+
+	rec := newRecorder(param.Protocol, len(entries)*loops, warmup)
+	rec.enableOperations()
+
+	// Start resource monitoring
+	monitorCtx, cancelMonitor := context.WithCancel(ctx)
+	defer cancelMonitor()
+	go service.monitorResources(monitorCtx, rec)
+
+	d := newDispatcher(service, session, &param.BaseParam, rec)
+	measuring := false
+	start := time.Now()
+
+replay:
+	for loop := 0; loop < loops; loop++ {
+		loopStart := start.Add(time.Duration(loop) * loopSpan)
+
+		for _, entry := range entries {
+			// Absolute schedule so sleep overshoot does not accumulate
+			if sleepUntil(ctx, loopStart.Add(entry.offset)) != nil {
+				break replay
+			}
+
+			isWarmup := warmup.next()
+			if !isWarmup && !measuring {
+				rec.startMeasuring()
+				measuring = true
+			}
+
+			// Entries are reused across loops, each send gets its own message ID
+			req := *entry.req
+			if req.protocol == "bl2" {
+				req.messageID = session.builder.messageID()
+			}
+
+			if !d.dispatchRequest(ctx, isWarmup, &req) {
+				break replay
+			}
+		}
+	}
+
+	// Wait for all requests to complete
+	d.wait()
+
+	rec.finish()
+	cancelMonitor()
+
+	result := &LoadReplayResult{
+		TestResult: *rec.result(),
+		Records:    len(entries),
+		Skipped:    skipped,
+		Loops:      loops,
+	}
+
+	return service.finishRun(ctx, "replay", &param.BaseParam, param, rec, result), nil
+}
+
+// readReplayRecords reads a JSONL request log from the replay directory,
+// skipping blank lines. The name must stay inside dir, symlinks included.
+func readReplayRecords(dir, name string) ([]ReplayRecord, error) {
+	const op errs.Op = "service/readReplayRecords"
+
+	if dir == "" {
+		return nil, errs.E(op, errs.Validation, "replay files are disabled, send the records inline")
+	}
+	if !filepath.IsLocal(name) {
+		return nil, errs.E(op, errs.Validation, "file must be a relative path inside the replay directory")
+	}
+
+	file, err := os.OpenInRoot(dir, name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errs.E(op, errs.Validation, fmt.Sprintf("replay file %s not found", name))
+		}
+		return nil, errs.E(op, errs.IO, err)
+	}
+	defer file.Close()
+
+	var records []ReplayRecord
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxReplayLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var record ReplayRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, errs.E(op, errs.Validation, fmt.Sprintf("invalid record on line %d: %v", line, err))
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.E(op, errs.IO, err)
+	}
+
+	return records, nil
+}
+
+// newReplayEntries converts records into requests ordered by timestamp, with
+// offsets from the first record scaled by speed. Requests are sent to the
+// gateway of their protocol, named in gateways; a record without a target is
+// meant for that gateway. Records not matching the service_name/protocol
+// filter are counted as skipped.
+func newReplayEntries(records []ReplayRecord, filter *BaseParam, gateways map[string]string, speed float64) ([]replayEntry, int, error) {
+	const op errs.Op = "service/newReplayEntries"
+
+	if speed <= 0 {
+		speed = 1
+	}
+
+	type timedRequest struct {
+		at  time.Time
+		req *request
+	}
+
+	var timed []timedRequest
+	skipped := 0
+	for i := range records {
+		record := &records[i]
+
+		target := record.Target
+		if target == "" {
+			target = gateways[record.Protocol]
+		}
+
+		if (filter.ServiceName != "" && target != filter.ServiceName) ||
+			(filter.Protocol != "" && record.Protocol != filter.Protocol) {
+			skipped++
+			continue
+		}
+
+		at, err := parseReplayTimestamp(record.Timestamp)
+		if err != nil {
+			return nil, 0, errs.E(op, errs.Validation, fmt.Sprintf("record %d: %v", i+1, err))
+		}
+
+		req, err := record.request(gateways)
+		if err != nil {
+			return nil, 0, errs.E(op, errs.Validation, fmt.Sprintf("record %d: %v", i+1, err))
+		}
+
+		timed = append(timed, timedRequest{at: at, req: req})
+	}
+
+	if len(timed) == 0 {
+		return nil, 0, errs.E(op, errs.Validation, "no records to replay")
+	}
+
+	sort.SliceStable(timed, func(i, j int) bool { return timed[i].at.Before(timed[j].at) })
+
+	entries := make([]replayEntry, len(timed))
+	for i, t := range timed {
+		entries[i] = replayEntry{
+			offset: time.Duration(float64(t.at.Sub(timed[0].at)) / speed),
+			req:    t.req,
+		}
+	}
+
+	return entries, skipped, nil
+}
+
+// request converts the record into a request for the adapters. Records whose
+// target is not the gateway of their protocol are rejected, as the adapters
+// only reach the gateways.
+func (record *ReplayRecord) request(gateways map[string]string) (*request, error) {
+	if record.Protocol != "bl2" && record.Protocol != "grpc" {
+		return nil, fmt.Errorf("invalid protocol: %q", record.Protocol)
+	}
+	if gateway := gateways[record.Protocol]; record.Target != "" && gateway != "" && record.Target != gateway {
+		return nil, fmt.Errorf("target %q is not the %s gateway %q", record.Target, record.Protocol, gateway)
+	}
+
+	req := &request{
+		protocol:  record.Protocol,
+		operation: record.Operation,
+		params:    record.Payload,
+	}
+
+	// Unwrap full BL2 messages
+	if params, ok := record.Payload["params"].(map[string]any); ok {
+		req.params = params
+		if req.operation == "" {
+			req.operation, _ = record.Payload["operation"].(string)
+		}
+	}
+
+	if req.operation == "" {
+		req.operation = OperationGetAccountByAccountNumber
+	}
+	if _, err := lookupOperation(req.operation, req.protocol); err != nil {
+		return nil, err
+	}
+	if req.params == nil {
+		req.params = map[string]any{}
+	}
+
+	return req, nil
+}
+
+// parseReplayTimestamp accepts RFC 3339 strings and unix seconds
+func parseReplayTimestamp(raw json.RawMessage) (time.Time, error) {
+	if len(raw) == 0 {
+		return time.Time{}, fmt.Errorf("timestamp is required")
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if at, err := time.Parse(time.RFC3339Nano, text); err == nil {
+			return at, nil
+		}
+		raw = json.RawMessage(text)
+	}
+
+	seconds, err := strconv.ParseFloat(string(raw), 64)
+	if err != nil || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %s", raw)
+	}
+
+	whole, frac := math.Modf(seconds)
+
+	return time.Unix(int64(whole), int64(frac*float64(time.Second))), nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var testGateways = map[string]string{"bl2": "py-gateway", "grpc": "go-gateway"}
+
+func replayRecord(seconds int, target, protocol string) ReplayRecord {
+	return ReplayRecord{
+		Timestamp: json.RawMessage(`"` + time.Unix(int64(seconds), 0).UTC().Format(time.RFC3339) + `"`),
+		Target:    target,
+		Protocol:  protocol,
+		Payload:   map[string]any{"account_number": "A"},
+	}
+}
+
+func TestNewReplayEntriesTargets(t *testing.T) {
+	tests := map[string]struct {
+		records     []ReplayRecord
+		filter      BaseParam
+		wantEntries int
+		wantSkipped int
+		wantErr     bool
+	}{
+		"gateway targets": {
+			records:     []ReplayRecord{replayRecord(0, "go-gateway", "grpc"), replayRecord(1, "py-gateway", "bl2")},
+			wantEntries: 2,
+		},
+		"no target": {
+			records:     []ReplayRecord{replayRecord(0, "", "grpc")},
+			wantEntries: 1,
+		},
+		"other target": {
+			records: []ReplayRecord{replayRecord(0, "go-core", "grpc")},
+			wantErr: true,
+		},
+		"gateway of other protocol": {
+			records: []ReplayRecord{replayRecord(0, "py-gateway", "grpc")},
+			wantErr: true,
+		},
+		"service filter": {
+			records:     []ReplayRecord{replayRecord(0, "go-gateway", "grpc"), replayRecord(1, "", "bl2")},
+			filter:      BaseParam{ServiceName: "py-gateway"},
+			wantEntries: 1,
+			wantSkipped: 1,
+		},
+		"filtered out target": {
+			records:     []ReplayRecord{replayRecord(0, "go-core", "grpc"), replayRecord(1, "py-gateway", "bl2")},
+			filter:      BaseParam{ServiceName: "py-gateway"},
+			wantEntries: 1,
+			wantSkipped: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			entries, skipped, err := newReplayEntries(tt.records, &tt.filter, testGateways, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+			if len(entries) != tt.wantEntries || skipped != tt.wantSkipped {
+				t.Fatalf("entries = %d, skipped = %d, want %d and %d", len(entries), skipped, tt.wantEntries, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestNewReplayEntriesOffsets(t *testing.T) {
+	records := []ReplayRecord{
+		replayRecord(4, "", "grpc"),
+		replayRecord(0, "", "grpc"),
+		replayRecord(2, "", "grpc"),
+	}
+
+	entries, _, err := newReplayEntries(records, &BaseParam{}, testGateways, 2)
+	if err != nil {
+		t.Fatalf("newReplayEntries: %v", err)
+	}
+
+	want := []time.Duration{0, time.Second, 2 * time.Second}
+	for i, entry := range entries {
+		if entry.offset != want[i] {
+			t.Fatalf("offset %d = %v, want %v", i, entry.offset, want[i])
+		}
+	}
+}
@@ -2,25 +2,24 @@ package service
 
 import (
 	"context"
-	"fmt"
-	"sync"
-	"time"
 
 	"load-tester/util/errs"
 
 	"github.com/sirupsen/logrus"
-	"golang.org/x/time/rate"
 )
 
 type LoadRpsParam struct {
 	BaseParam
+	ArrivalParam
 	TotalReqs int `json:"total_reqs"` // Total number of requests to send
 	RPS       int `json:"rps"`        // Requests per second
 }
 
 type LoadRpsResult = TestResult
 
-// Constant RPS test - sends requests at a constant rate until total requests are sent
+// Constant RPS test - sends requests at a constant average rate until total requests are sent.
+// The spacing between requests follows the selected arrival process.
+// Warmup requests are sent at the same rate before the measured requests.
 func (service *Service) LoadRps(ctx context.Context, param *LoadRpsParam) (map[string]any, error) {
 	const op errs.Op = "service/LoadRps"
 
@@ -29,132 +28,69 @@ func (service *Service) LoadRps(ctx context.Context, param *LoadRpsParam) (map[s
 		"param": param,
 	}).Info("Starting RPS load test")
 
-	// Initialize metrics
-	metrics := &Metrics{
-		Latencies:   make([]time.Duration, 0, param.TotalReqs),
-		StartTime:   time.Now(),
-		CPUUsage:    make([]float64, 0),
-		MemoryUsage: make([]uint64, 0),
-		Errors:      make(map[string]int),
+	warmup, err := newWarmupPhase(param.Warmup)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	// Prepare the adapters and the request mix
+	session, err := service.newSession(&param.BaseParam)
+	if err != nil {
+		return nil, errs.E(op, err)
 	}
+	defer session.close()
 
-	// Create rate limiter for RPS control
-	limiter := rate.NewLimiter(rate.Limit(param.RPS), param.RPS) // burst size equals to RPS
+	// Create the arrival process for RPS control
+	arrivals, err := newArrivalProcess(&param.ArrivalParam, session.seed, float64(param.RPS))
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
 
-	// Create channels for collecting metrics
-	latencyChan := make(chan time.Duration, param.TotalReqs)
-	errorChan := make(chan error, param.TotalReqs)
+	// Initialize metrics
+	rec := newRecorder(param.Protocol, param.TotalReqs, warmup)
 
 	// Start resource monitoring
 	monitorCtx, cancelMonitor := context.WithCancel(ctx)
 	defer cancelMonitor()
-	go service.monitorResources(monitorCtx, metrics)
+	go service.monitorResources(monitorCtx, rec)
 
-	var wg sync.WaitGroup
+	d := newDispatcher(service, session, &param.BaseParam, rec)
 	requestCount := 0
-
-	// Create a done channel to signal completion
-	done := make(chan struct{})
-
-	// Start a goroutine to collect results
-	go func() {
-		for {
-			select {
-			case latency, ok := <-latencyChan:
-				if !ok {
-					return
-				}
-				metrics.Latencies = append(metrics.Latencies, latency)
-			case err, ok := <-errorChan:
-				if !ok {
-					return
-				}
-				errStr := err.Error()
-				metrics.Errors[errStr]++
-
-				// Categorize errors
-				switch err {
-				case ErrTimeout:
-					metrics.TimeoutRequests.Add(1)
-				case ErrDropped:
-					metrics.DroppedRequests.Add(1)
-				default:
-					metrics.FailedRequests.Add(1)
-				}
-			case <-done:
-				return
-			}
-		}
-	}()
+	measuring := false
 
 	// Main test loop
 	for requestCount < param.TotalReqs {
-		// Wait for rate limiter
-		err := limiter.Wait(ctx)
+		// Wait for the next arrival
+		err := arrivals.wait(ctx)
 		if err != nil {
 			// Context cancelled or other error
 			break
 		}
 
+		isWarmup := warmup.next()
+		if !isWarmup && !measuring {
+			rec.startMeasuring()
+			measuring = true
+		}
+
 		// Launch request
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
-			start := time.Now()
-			err := service.executeRequest(ctx, &param.BaseParam)
-			latency := time.Since(start)
-
-			latencyChan <- latency
-			if err != nil {
-				errorChan <- err
-			} else {
-				metrics.SuccessfulRequests.Add(1)
-			}
-			metrics.TotalRequests.Add(1)
-		}()
-
-		requestCount++
+		if !d.dispatch(ctx, isWarmup) {
+			break
+		}
+
+		if !isWarmup {
+			requestCount++
+		}
 	}
 
 	// Wait for all requests to complete
-	wg.Wait()
-
-	// Signal collection goroutine to stop and close channels
-	close(latencyChan)
-	close(errorChan)
-	close(done)
+	d.wait()
 
-	metrics.EndTime = time.Now()
+	rec.finish()
+	cancelMonitor()
 
 	// Convert metrics to test result
-	result := &LoadRpsResult{
-		Summary: Summary{
-			TestDuration:       metrics.EndTime.Sub(metrics.StartTime).String(),
-			TotalRequests:      fmt.Sprintf("%d", metrics.TotalRequests.Load()),
-			SuccessfulRequests: fmt.Sprintf("%d", metrics.SuccessfulRequests.Load()),
-			FailedRequests:     fmt.Sprintf("%d", metrics.FailedRequests.Load()),
-			TimeoutRequests:    fmt.Sprintf("%d", metrics.TimeoutRequests.Load()),
-			DroppedRequests:    fmt.Sprintf("%d", metrics.DroppedRequests.Load()),
-			AverageRPS:         fmt.Sprintf("%.2f", float64(metrics.TotalRequests.Load())/metrics.EndTime.Sub(metrics.StartTime).Seconds()),
-			P95LatencyMs:       fmt.Sprintf("%.2f", calculatePercentileLatency(metrics.Latencies, 95)),
-			P99LatencyMs:       fmt.Sprintf("%.2f", calculatePercentileLatency(metrics.Latencies, 99)),
-		},
-		ResourceMetrics: ResourceMetric{
-			CPU: CPU{
-				AverageUsage: calculateAverage(metrics.CPUUsage),
-				PeakUsage:    calculatePeak(metrics.CPUUsage),
-				UsagePattern: metrics.CPUUsage,
-			},
-			Memory: Memory{
-				AverageMB: float64(calculateAverage(convertToFloat64(metrics.MemoryUsage))) / 1024 / 1024,
-				PeakMB:    float64(calculatePeak(convertToFloat64(metrics.MemoryUsage))) / 1024 / 1024,
-			},
-		},
-		Errors: metrics.Errors,
-	}
+	result := rec.result()
 
-	return map[string]any{
-		"result": result,
-	}, nil
+	return service.finishRun(ctx, "rps", &param.BaseParam, param, rec, result), nil
 }
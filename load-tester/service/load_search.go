@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"load-tester/util/errs"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultSearchMinRPS    = 10
+	defaultSearchPrecision = 0.05
+	defaultSearchMaxProbes = 20
+
+	// searchMinAchievedRatio is the share of the target rate a probe must
+	// actually deliver to pass
+	searchMinAchievedRatio = 0.9
+)
+
+type LoadSearchParam struct {
+	BaseParam
+	ArrivalParam
+	MinRPS        int     `json:"min_rps"`        // First probe, defaults to 10
+	MaxRPS        int     `json:"max_rps"`        // Highest rate to probe
+	StageDuration string  `json:"stage_duration"` // Length of the steady stage run at each probe
+	SLOP99Ms      float64 `json:"slo_p99_ms"`     // Highest acceptable p99 latency
+	SLOErrorRate  float64 `json:"slo_error_rate"` // Highest acceptable error rate, e.g. 0.01
+	Precision     float64 `json:"precision"`      // Stop when the bracket is within this share of the rate, defaults to 0.05
+	MaxProbes     int     `json:"max_probes"`     // Upper bound on probes, defaults to 20
+}
+
+// SearchProbe is the outcome of one steady stage
+type SearchProbe struct {
+	TargetRPS    int     `json:"target_rps"`
+	AchievedRPS  float64 `json:"achieved_rps"`
+	Requests     int64   `json:"requests"`
+	ErrorRate    float64 `json:"error_rate"`
+	P95LatencyMs float64 `json:"p95_latency_ms"`
+	P99LatencyMs float64 `json:"p99_latency_ms"`
+	Passed       bool    `json:"passed"`
+	Reason       string  `json:"reason,omitempty"`
+}
+
+type LoadSearchResult struct {
+	CapacityRPS int           `json:"capacity_rps"`
+	Probes      []SearchProbe `json:"probes"`
+	Capacity    *TestResult   `json:"capacity"` // Full result of the probe at capacity
+	Warmup      *WarmupResult `json:"warmup,omitempty"`
+	Canceled    bool          `json:"canceled,omitempty"` // Stopped before the search finished, capacity_rps is the best rate confirmed so far
+}
+
+// Capacity search - probes exponentially increasing rates until the SLO is
+// missed, then bisects between the last passing and the first failing rate.
+// Warmup requests are sent at min_rps before the first probe.
+func (service *Service) LoadSearch(ctx context.Context, param *LoadSearchParam) (map[string]any, error) {
+	const op errs.Op = "service/LoadSearch"
+
+	service.logger.WithFields(logrus.Fields{
+		"op":    op,
+		"param": param,
+	}).Info("Starting capacity search")
+
+	stageDuration, err := time.ParseDuration(param.StageDuration)
+	if err != nil || stageDuration <= 0 {
+		return nil, errs.E(op, errs.Validation, "stage_duration must be a positive duration")
+	}
+
+	warmup, err := newWarmupPhase(param.Warmup)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	// Prepare the adapters and the request mix
+	session, err := service.newSession(&param.BaseParam)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+	defer session.close()
+
+	minRPS := param.MinRPS
+	if minRPS <= 0 {
+		minRPS = defaultSearchMinRPS
+	}
+	precision := param.Precision
+	if precision <= 0 {
+		precision = defaultSearchPrecision
+	}
+	maxProbes := param.MaxProbes
+	if maxProbes <= 0 {
+		maxProbes = defaultSearchMaxProbes
+	}
+
+	result := &LoadSearchResult{}
+
+	// Warm up at the starting rate
+	if warmup.enabled() {
+		warmupRec := newRecorder(param.Protocol, 0, warmup)
+		arrivals, err := newArrivalProcess(&param.ArrivalParam, session.seed, float64(minRPS))
+		if err != nil {
+			return nil, errs.E(op, err)
+		}
+
+		d := newDispatcher(service, session, &param.BaseParam, warmupRec)
+		for warmup.next() {
+			if arrivals.wait(ctx) != nil || !d.dispatch(ctx, true) {
+				break
+			}
+		}
+		d.wait()
+
+		result.Warmup = warmupRec.result().Warmup
+	}
+
+	var best, last *recorder
+
+	probe := func(rps int) (bool, error) {
+		rec, err := service.runSteadyStage(ctx, session, param, rps, stageDuration)
+		if err != nil {
+			return false, err
+		}
+
+		// A stage cut short by cancellation says nothing about the rate
+		if ctx.Err() != nil {
+			return false, nil
+		}
+		last = rec
+
+		outcome := evaluateProbe(rec, rps, param)
+		result.Probes = append(result.Probes, outcome)
+
+		service.logger.WithFields(logrus.Fields{
+			"op":     op,
+			"probe":  fmt.Sprintf("%+v", outcome),
+			"passed": outcome.Passed,
+		}).Info("Capacity probe finished")
+
+		if outcome.Passed && rps > result.CapacityRPS {
+			result.CapacityRPS = rps
+			best = rec
+		}
+
+		return outcome.Passed, nil
+	}
+
+	canceled, err := searchCapacity(ctx, &searchBounds{
+		minRPS:    minRPS,
+		maxRPS:    param.MaxRPS,
+		precision: precision,
+		maxProbes: maxProbes,
+	}, probe)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+	result.Canceled = canceled
+
+	if last == nil {
+		return nil, errs.E(op, ctx.Err())
+	}
+
+	rec := best
+	if rec == nil {
+		rec = last
+	}
+	result.Capacity = rec.result()
+
+	return service.finishRun(ctx, "search", &param.BaseParam, param, rec, result), nil
+}
+
+// searchBounds limits a capacity search
+type searchBounds struct {
+	minRPS    int
+	maxRPS    int // 0 for no upper bound
+	precision float64
+	maxProbes int
+}
+
+// searchCapacity probes exponentially increasing rates from minRPS until one
+// fails, then bisects between the last passing and the first failing rate.
+// It stops early when ctx is done, reporting the search as canceled; the
+// probe in progress then neither passes nor fails.
+func searchCapacity(ctx context.Context, bounds *searchBounds, probe func(rps int) (bool, error)) (canceled bool, err error) {
+	probes := 0
+	run := func(rps int) (bool, error) {
+		probes++
+		return probe(rps)
+	}
+
+	// Exponential probing to bracket the capacity
+	low, high := 0, 0
+	for rps := bounds.minRPS; probes < bounds.maxProbes; rps *= 2 {
+		if bounds.maxRPS > 0 && rps > bounds.maxRPS {
+			rps = bounds.maxRPS
+		}
+
+		passed, err := run(rps)
+		if err != nil {
+			return false, err
+		}
+		if ctx.Err() != nil {
+			return true, nil
+		}
+		if !passed {
+			high = rps
+			break
+		}
+
+		low = rps
+		if rps == bounds.maxRPS {
+			break
+		}
+	}
+
+	// Bisection between the last passing and the first failing rate
+	for high > 0 && probes < bounds.maxProbes {
+		if float64(high-low) <= max(1, bounds.precision*float64(high)) {
+			break
+		}
+
+		mid := low + (high-low)/2
+		passed, err := run(mid)
+		if err != nil {
+			return false, err
+		}
+		if ctx.Err() != nil {
+			return true, nil
+		}
+		if passed {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return false, nil
+}
+
+// runSteadyStage sends requests at a constant average rate for duration
+func (service *Service) runSteadyStage(ctx context.Context, session *session, param *LoadSearchParam, rps int, duration time.Duration) (*recorder, error) {
+	arrivals, err := newArrivalProcess(&param.ArrivalParam, session.seed, float64(rps))
+	if err != nil {
+		return nil, err
+	}
+
+	rec := newRecorder(param.Protocol, int(float64(rps)*duration.Seconds()), &warmupPhase{})
+
+	monitorCtx, cancelMonitor := context.WithCancel(ctx)
+	defer cancelMonitor()
+	go service.monitorResources(monitorCtx, rec)
+
+	rec.startMeasuring()
+
+	d := newDispatcher(service, session, &param.BaseParam, rec)
+	d.dispatchFor(ctx, arrivals, duration)
+	rec.stopSending()
+	d.wait()
+
+	rec.finish()
+
+	return rec, nil
+}
+
+// evaluateProbe checks a finished stage against the SLO
+func evaluateProbe(rec *recorder, rps int, param *LoadSearchParam) SearchProbe {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	metrics := rec.measured
+	total := metrics.TotalRequests.Load()
+	clientDropped := metrics.ClientDropped.Load()
+	failed := total - metrics.SuccessfulRequests.Load() + clientDropped
+
+	probe := SearchProbe{
+		TargetRPS:    rps,
+		Requests:     total,
+		ErrorRate:    proportion(failed, total+clientDropped),
+		P95LatencyMs: latencyMs(calculatePercentile(metrics.Latencies, 95)),
+		P99LatencyMs: latencyMs(calculatePercentile(metrics.Latencies, 99)),
+		Passed:       true,
+	}
+
+	// Measured over the send window, the drain of the last in-flight requests
+	// would otherwise lower the achieved rate
+	if duration := metrics.SendEndTime.Sub(metrics.StartTime); duration > 0 {
+		probe.AchievedRPS = float64(total) / duration.Seconds()
+	}
+
+	switch {
+	case param.SLOP99Ms > 0 && probe.P99LatencyMs > param.SLOP99Ms:
+		probe.Passed = false
+		probe.Reason = fmt.Sprintf("p99 %.2fms above SLO %.2fms", probe.P99LatencyMs, param.SLOP99Ms)
+	case probe.ErrorRate > param.SLOErrorRate:
+		probe.Passed = false
+		probe.Reason = fmt.Sprintf("error rate %.4f above SLO %.4f", probe.ErrorRate, param.SLOErrorRate)
+	case probe.AchievedRPS < float64(rps)*searchMinAchievedRatio:
+		probe.Passed = false
+		probe.Reason = fmt.Sprintf("achieved %.2f rps, below %.0f%% of target", probe.AchievedRPS, searchMinAchievedRatio*100)
+	}
+
+	return probe
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSearchCapacity(t *testing.T) {
+	tests := map[string]struct {
+		bounds   searchBounds
+		capacity int   // Highest rate the fake target sustains
+		want     []int // Probed rates
+	}{
+		"bracket and bisect": {
+			bounds:   searchBounds{minRPS: 10, precision: 0.05, maxProbes: 20},
+			capacity: 100,
+			want:     []int{10, 20, 40, 80, 160, 120, 100, 110, 105},
+		},
+		"first probe fails": {
+			bounds:   searchBounds{minRPS: 10, precision: 0.05, maxProbes: 20},
+			capacity: 5,
+			want:     []int{10, 5, 7, 6},
+		},
+		"capped at max rps": {
+			bounds:   searchBounds{minRPS: 10, maxRPS: 50, precision: 0.05, maxProbes: 20},
+			capacity: 1000,
+			want:     []int{10, 20, 40, 50},
+		},
+		"probe limit": {
+			bounds:   searchBounds{minRPS: 10, precision: 0.05, maxProbes: 3},
+			capacity: 1000,
+			want:     []int{10, 20, 40},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var probed []int
+			canceled, err := searchCapacity(context.Background(), &tt.bounds, func(rps int) (bool, error) {
+				probed = append(probed, rps)
+				return rps <= tt.capacity, nil
+			})
+			if err != nil {
+				t.Fatalf("searchCapacity: %v", err)
+			}
+			if canceled {
+				t.Fatal("search reported as canceled")
+			}
+			if !slices.Equal(probed, tt.want) {
+				t.Fatalf("probed = %v, want %v", probed, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchCapacityCanceled(t *testing.T) {
+	for name, cancelAt := range map[string]int{"exponential phase": 40, "bisection": 120} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var probed []int
+			canceled, err := searchCapacity(ctx, &searchBounds{minRPS: 10, precision: 0.05, maxProbes: 20}, func(rps int) (bool, error) {
+				probed = append(probed, rps)
+				if rps == cancelAt {
+					// The stage is cut short and comes back as not passed
+					cancel()
+					return false, nil
+				}
+				return rps <= 100, nil
+			})
+			if err != nil {
+				t.Fatalf("searchCapacity: %v", err)
+			}
+			if !canceled {
+				t.Fatal("search not reported as canceled")
+			}
+			if probed[len(probed)-1] != cancelAt {
+				t.Fatalf("probed = %v, want to stop at %d", probed, cancelAt)
+			}
+		})
+	}
+}
+
+func TestSearchCapacityProbeError(t *testing.T) {
+	probeErr := errors.New("invalid arrival")
+
+	_, err := searchCapacity(context.Background(), &searchBounds{minRPS: 10, precision: 0.05, maxProbes: 20}, func(rps int) (bool, error) {
+		return false, probeErr
+	})
+	if !errors.Is(err, probeErr) {
+		t.Fatalf("err = %v, want %v", err, probeErr)
+	}
+}
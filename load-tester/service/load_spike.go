@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"load-tester/util/errs"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultSpikeTolerance          = 0.25 // p95 may exceed the baseline by 25%
+	defaultSpikeErrorRateTolerance = 0.01 // error rate may exceed the baseline by 1 percentage point
+
+	// recoveryStableIntervals is the number of consecutive intervals that must be
+	// within tolerance before the target counts as recovered
+	recoveryStableIntervals = 3
+	spikeTimelineInterval   = time.Second
+)
+
+type LoadSpikeParam struct {
+	BaseParam
+	ArrivalParam
+	BaselineRPS        int     `json:"baseline_rps"`         // Rate before and after the spike
+	SpikeRPS           int     `json:"spike_rps"`            // Rate during the spike
+	BaselineDuration   string  `json:"baseline_duration"`    // Pre-spike period used as the baseline
+	SpikeDuration      string  `json:"spike_duration"`       // Length of the spike
+	ObservationWindow  string  `json:"observation_window"`   // Post-spike period at the baseline rate
+	Tolerance          float64 `json:"tolerance"`            // Relative p95 tolerance, defaults to 0.25
+	ErrorRateTolerance float64 `json:"error_rate_tolerance"` // Absolute error rate tolerance, defaults to 0.01
+}
+
+// SpikePhase summarizes one phase of a spike test
+type SpikePhase struct {
+	Name         string  `json:"name"`
+	RPS          int     `json:"rps"`
+	Duration     string  `json:"duration"`
+	Requests     int64   `json:"requests"`
+	Errors       int64   `json:"errors"`
+	ErrorRate    float64 `json:"error_rate"`
+	P95LatencyMs float64 `json:"p95_latency_ms"`
+	P99LatencyMs float64 `json:"p99_latency_ms"`
+}
+
+// SpikeRecovery reports how long the target needed to return to its baseline
+type SpikeRecovery struct {
+	Recovered            bool    `json:"recovered"`
+	TimeToRecover        string  `json:"time_to_recover"`
+	TimeToRecoverSeconds float64 `json:"time_to_recover_seconds"`
+	BaselineP95LatencyMs float64 `json:"baseline_p95_latency_ms"`
+	P95LimitMs           float64 `json:"p95_limit_ms"`
+	BaselineErrorRate    float64 `json:"baseline_error_rate"`
+	ErrorRateLimit       float64 `json:"error_rate_limit"`
+}
+
+type LoadSpikeResult struct {
+	TestResult
+	Phases   []SpikePhase    `json:"phases"`
+	Recovery SpikeRecovery   `json:"recovery"`
+	Timeline []TimelinePoint `json:"timeline"`
+}
+
+// spikeStage is one constant-rate stage of a spike test
+type spikeStage struct {
+	name     string
+	rps      int
+	duration time.Duration
+}
+
+// Spike test - runs at the baseline rate, jumps to the spike rate, then drops
+// back and measures how long p95 and error rate need to return to the baseline.
+// Warmup requests are sent at the baseline rate before the baseline stage.
+func (service *Service) LoadSpike(ctx context.Context, param *LoadSpikeParam) (map[string]any, error) {
+	const op errs.Op = "service/LoadSpike"
+
+	service.logger.WithFields(logrus.Fields{
+		"op":    op,
+		"param": param,
+	}).Info("Starting spike load test")
+
+	stages, err := parseSpikeStages(param)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	warmup, err := newWarmupPhase(param.Warmup)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	// Prepare the adapters and the request mix
+	session, err := service.newSession(&param.BaseParam)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+	defer session.close()
+
+	arrivals, err := newArrivalProcess(&param.ArrivalParam, session.seed, float64(param.BaselineRPS))
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	var expected int
+	for _, stage := range stages {
+		expected += int(float64(stage.rps) * stage.duration.Seconds())
+	}
+
+	rec := newRecorder(param.Protocol, expected, warmup)
+	rec.enableTimeline(spikeTimelineInterval)
+
+	// Start resource monitoring
+	monitorCtx, cancelMonitor := context.WithCancel(ctx)
+	defer cancelMonitor()
+	go service.monitorResources(monitorCtx, rec)
+
+	d := newDispatcher(service, session, &param.BaseParam, rec)
+
+	// Warm up at the baseline rate
+	for warmup.next() {
+		if err := arrivals.wait(ctx); err != nil {
+			break
+		}
+		if !d.dispatch(ctx, true) {
+			break
+		}
+	}
+	rec.startMeasuring()
+
+	for _, stage := range stages {
+		arrivals.setRate(float64(stage.rps))
+		if !d.dispatchFor(ctx, arrivals, stage.duration) {
+			break
+		}
+	}
+
+	// Wait for all requests to complete
+	d.wait()
+
+	rec.finish()
+	cancelMonitor()
+
+	result := &LoadSpikeResult{
+		TestResult: *rec.result(),
+		Timeline:   rec.timeline.points(),
+	}
+
+	var offset time.Duration
+	for _, stage := range stages {
+		window := rec.timeline.window(offset, offset+stage.duration)
+		result.Phases = append(result.Phases, SpikePhase{
+			Name:         stage.name,
+			RPS:          stage.rps,
+			Duration:     stage.duration.String(),
+			Requests:     window.requests,
+			Errors:       window.errors,
+			ErrorRate:    window.errorRate(),
+			P95LatencyMs: latencyMs(calculatePercentile(window.latencies, 95)),
+			P99LatencyMs: latencyMs(calculatePercentile(window.latencies, 99)),
+		})
+		offset += stage.duration
+	}
+
+	tolerance := param.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultSpikeTolerance
+	}
+	errorRateTolerance := param.ErrorRateTolerance
+	if errorRateTolerance <= 0 {
+		errorRateTolerance = defaultSpikeErrorRateTolerance
+	}
+
+	result.Recovery = measureRecovery(rec.timeline, stages[0].duration, stages[0].duration+stages[1].duration, tolerance, errorRateTolerance)
+
+	return service.finishRun(ctx, "spike", &param.BaseParam, param, rec, result), nil
+}
+
+// parseSpikeStages builds the baseline, spike and observation stages
+func parseSpikeStages(param *LoadSpikeParam) ([]spikeStage, error) {
+	const op errs.Op = "service/parseSpikeStages"
+
+	durations := make([]time.Duration, 3)
+	for i, field := range []struct {
+		name  string
+		value string
+	}{
+		{"baseline_duration", param.BaselineDuration},
+		{"spike_duration", param.SpikeDuration},
+		{"observation_window", param.ObservationWindow},
+	} {
+		duration, err := time.ParseDuration(field.value)
+		if err != nil || duration <= 0 {
+			return nil, errs.E(op, errs.Validation, field.name+" must be a positive duration")
+		}
+		durations[i] = duration
+	}
+
+	// The stable intervals must fit after the spike, one more interval covers
+	// the partial interval the spike ends in
+	minObservation := (recoveryStableIntervals + 1) * spikeTimelineInterval
+	if durations[2] < minObservation {
+		return nil, errs.E(op, errs.Validation, "observation_window must be at least "+minObservation.String()+" to measure recovery")
+	}
+
+	return []spikeStage{
+		{name: "baseline", rps: param.BaselineRPS, duration: durations[0]},
+		{name: "spike", rps: param.SpikeRPS, duration: durations[1]},
+		{name: "observation", rps: param.BaselineRPS, duration: durations[2]},
+	}, nil
+}
+
+// measureRecovery finds the first interval after spikeEnd from which p95 and
+// error rate stay within tolerance of the baseline window [0, baselineEnd)
+func measureRecovery(tl *timeline, baselineEnd, spikeEnd time.Duration, tolerance, errorRateTolerance float64) SpikeRecovery {
+	baseline := tl.window(0, baselineEnd)
+	baselineP95 := latencyMs(calculatePercentile(baseline.latencies, 95))
+
+	recovery := SpikeRecovery{
+		BaselineP95LatencyMs: baselineP95,
+		P95LimitMs:           baselineP95 * (1 + tolerance),
+		BaselineErrorRate:    baseline.errorRate(),
+		ErrorRateLimit:       baseline.errorRate() + errorRateTolerance,
+	}
+
+	withinTolerance := func(bucket *timelineBucket) bool {
+		// An interval without completed requests is a stalled target, not a recovered one
+		if bucket.requests == 0 {
+			return false
+		}
+
+		return latencyMs(calculatePercentile(bucket.latencies, 95)) <= recovery.P95LimitMs &&
+			bucket.errorRate() <= recovery.ErrorRateLimit
+	}
+
+	first := int((spikeEnd + tl.interval - 1) / tl.interval)
+	stable := 0
+	for i := first; i < len(tl.buckets); i++ {
+		if !withinTolerance(tl.buckets[i]) {
+			stable = 0
+			continue
+		}
+
+		stable++
+		if stable == recoveryStableIntervals {
+			recoveredAt := time.Duration(i-recoveryStableIntervals+1)*tl.interval - spikeEnd
+			recovery.Recovered = true
+			recovery.TimeToRecover = recoveredAt.String()
+			recovery.TimeToRecoverSeconds = recoveredAt.Seconds()
+			break
+		}
+	}
+
+	return recovery
+}
+
+// latencyMs converts a duration to fractional milliseconds
+func latencyMs(latency time.Duration) float64 {
+	return float64(latency.Microseconds()) / 1000
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// interval describes the requests sent in one timeline interval
+type interval struct {
+	requests  int
+	latencyMs int
+	failed    bool
+}
+
+func newTestTimeline(intervals []interval) *timeline {
+	origin := time.Now()
+	tl := newTimeline(origin, time.Second)
+
+	for i, iv := range intervals {
+		for r := 0; r < iv.requests; r++ {
+			var err error
+			if iv.failed {
+				err = errors.New("server error")
+			}
+			start := origin.Add(time.Duration(i)*time.Second + time.Duration(r)*time.Millisecond)
+			tl.add(start, time.Duration(iv.latencyMs)*time.Millisecond, err)
+		}
+	}
+
+	return tl
+}
+
+func TestMeasureRecovery(t *testing.T) {
+	var (
+		fast    = interval{requests: 10, latencyMs: 10}
+		slow    = interval{requests: 10, latencyMs: 100}
+		failing = interval{requests: 10, latencyMs: 10, failed: true}
+		empty   = interval{}
+	)
+
+	// Two baseline intervals, two spike intervals, then the observation
+	tests := map[string]struct {
+		observation []interval
+		recovered   bool
+		recoverIn   time.Duration
+	}{
+		"immediate recovery": {observation: []interval{fast, fast, fast}, recovered: true, recoverIn: 0},
+		"late recovery":      {observation: []interval{slow, fast, fast, fast}, recovered: true, recoverIn: time.Second},
+		"interrupted":        {observation: []interval{fast, fast, slow, fast, fast, fast}, recovered: true, recoverIn: 3 * time.Second},
+		"no recovery":        {observation: []interval{slow, slow, slow, slow, slow}},
+		"too short":          {observation: []interval{slow, fast, fast}},
+		"errors":             {observation: []interval{failing, failing, failing, failing}},
+		"empty intervals":    {observation: []interval{empty, empty, empty, fast, fast, fast}, recovered: true, recoverIn: 3 * time.Second},
+		"stalled target":     {observation: []interval{empty, empty, empty, empty, fast}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			intervals := append([]interval{fast, fast, slow, slow}, tt.observation...)
+			tl := newTestTimeline(intervals)
+
+			recovery := measureRecovery(tl, 2*time.Second, 4*time.Second, 0.5, 0.01)
+
+			if recovery.BaselineP95LatencyMs != 10 {
+				t.Fatalf("baseline p95 = %.2fms, want 10ms", recovery.BaselineP95LatencyMs)
+			}
+			if recovery.Recovered != tt.recovered {
+				t.Fatalf("recovered = %t, want %t", recovery.Recovered, tt.recovered)
+			}
+			if tt.recovered && recovery.TimeToRecoverSeconds != tt.recoverIn.Seconds() {
+				t.Fatalf("time to recover = %s, want %s", recovery.TimeToRecover, tt.recoverIn)
+			}
+		})
+	}
+}
+
+func TestParseSpikeStages(t *testing.T) {
+	tests := map[string]struct {
+		observation string
+		wantErr     bool
+	}{
+		"long enough": {observation: "10s"},
+		"minimum":     {observation: "4s"},
+		"too short":   {observation: "3s", wantErr: true},
+		"invalid":     {observation: "later", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseSpikeStages(&LoadSpikeParam{
+				BaselineRPS:       10,
+				SpikeRPS:          100,
+				BaselineDuration:  "10s",
+				SpikeDuration:     "5s",
+				ObservationWindow: tt.observation,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"load-tester/util/errs"
+
+	"github.com/google/uuid"
+)
+
+// seqPlaceholder is replaced by the request sequence number in payload templates
+const seqPlaceholder = "{{seq}}"
+
+// OperationMix is one operation of a weighted request mix
+type OperationMix struct {
+	Operation string         `json:"operation"`
+	Weight    float64        `json:"weight"`  // Relative share of the requests
+	Payload   map[string]any `json:"payload"` // Params template, see expandTemplate
+}
+
+// requestBuilder creates the requests of a test from its operation mix. It is
+// used by the request generator only and is not safe for concurrent use.
+type requestBuilder struct {
+	protocol string
+	mix      []OperationMix
+	mixed    bool // Whether the test declared a mix
+
+	// cumulative weights of mix, for weighted selection
+	cumulative []float64
+
+	rng *rand.Rand
+	seq int64
+
+	// messageIDs generates the BL2 message IDs, apart from rng so the IDs
+	// do not depend on the mix. It is keyed by the seed and message_id_nonce.
+	messageIDs *rand.ChaCha8
+}
+
+// newRequestBuilder validates the mix of param. Without a mix every request
+// is an account inquiry for the payload account number. The same seed gives
+// the same sequence of requests and message IDs.
+func newRequestBuilder(param *BaseParam, seed int64) (*requestBuilder, error) {
+	const op errs.Op = "service/newRequestBuilder"
+
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:], uint64(seed))
+	binary.LittleEndian.PutUint64(key[8:], param.MessageIDNonce)
+
+	builder := &requestBuilder{
+		protocol:   param.Protocol,
+		mix:        param.Mix,
+		mixed:      len(param.Mix) > 0,
+		rng:        rand.New(rand.NewPCG(uint64(seed), uint64(seed))),
+		messageIDs: rand.NewChaCha8(key),
+	}
+
+	if !builder.mixed {
+		builder.mix = []OperationMix{{
+			Operation: OperationGetAccountByAccountNumber,
+			Weight:    1,
+			Payload: map[string]any{
+				"account_number": param.Payload.AccountNumber,
+			},
+		}}
+	}
+
+	total := 0.0
+	for i, entry := range builder.mix {
+		if builder.mixed {
+			spec, err := lookupOperation(entry.Operation, param.Protocol)
+			if err != nil {
+				return nil, errs.E(op, errs.Validation, fmt.Sprintf("mix[%d]: %v", i, err))
+			}
+
+			for _, name := range spec.required {
+				if _, ok := entry.Payload[name]; !ok {
+					return nil, errs.E(op, errs.Validation, fmt.Sprintf("mix[%d]: payload.%s is required for %s", i, name, entry.Operation))
+				}
+			}
+		}
+
+		if entry.Weight <= 0 {
+			return nil, errs.E(op, errs.Validation, fmt.Sprintf("mix[%d]: weight must be greater than 0", i))
+		}
+
+		total += entry.Weight
+		builder.cumulative = append(builder.cumulative, total)
+	}
+
+	return builder, nil
+}
+
+// next picks an operation by weight and fills its payload template
+func (builder *requestBuilder) next() *request {
+	builder.seq++
+
+	entry := &builder.mix[0]
+	if len(builder.mix) > 1 {
+		total := builder.cumulative[len(builder.cumulative)-1]
+		i := sort.SearchFloat64s(builder.cumulative, builder.rng.Float64()*total)
+		entry = &builder.mix[min(i, len(builder.mix)-1)]
+	}
+
+	params, _ := builder.expandTemplate(entry.Payload).(map[string]any)
+
+	req := &request{
+		protocol:  builder.protocol,
+		operation: entry.Operation,
+		params:    params,
+	}
+	if req.protocol == "bl2" {
+		req.messageID = builder.messageID()
+	}
+
+	return req
+}
+
+// messageID returns the next BL2 id_message
+func (builder *requestBuilder) messageID() string {
+	id, err := uuid.NewRandomFromReader(builder.messageIDs)
+	if err != nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+// expandTemplate fills a payload template for one request. A list picks one
+// of its elements at random, "{{seq}}" in a string is replaced by the request
+// sequence number, and objects are expanded field by field.
+func (builder *requestBuilder) expandTemplate(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		// Fields are expanded in key order, map order would change which
+		// random choices each field gets between runs of the same seed
+		expanded := make(map[string]any, len(v))
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			expanded[key] = builder.expandTemplate(v[key])
+		}
+		return expanded
+
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		return builder.expandTemplate(v[builder.rng.IntN(len(v))])
+
+	case string:
+		if strings.Contains(v, seqPlaceholder) {
+			return strings.ReplaceAll(v, seqPlaceholder, strconv.FormatInt(builder.seq, 10))
+		}
+		return v
+
+	default:
+		return v
+	}
+}
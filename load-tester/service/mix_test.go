@@ -0,0 +1,166 @@
+package service
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func testMix() []OperationMix {
+	return []OperationMix{
+		{Operation: OperationGetAccountByAccountNumber, Weight: 3, Payload: map[string]any{"account_number": "A"}},
+		{Operation: OperationGetAccountByAccountNumber, Weight: 1, Payload: map[string]any{"account_number": "B"}},
+	}
+}
+
+func TestNewRequestBuilderValidation(t *testing.T) {
+	tests := map[string]struct {
+		mix     []OperationMix
+		wantErr bool
+	}{
+		"no mix":            {},
+		"mix":               {mix: testMix()},
+		"unknown operation": {mix: []OperationMix{{Operation: "transfer", Weight: 1}}, wantErr: true},
+		"missing param":     {mix: []OperationMix{{Operation: OperationGetAccountByAccountNumber, Weight: 1}}, wantErr: true},
+		"zero weight": {
+			mix:     []OperationMix{{Operation: OperationGetAccountByAccountNumber, Payload: map[string]any{"account_number": "A"}}},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newRequestBuilder(&BaseParam{Protocol: "grpc", Mix: tt.mix}, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestBuilderWeights(t *testing.T) {
+	builder, err := newRequestBuilder(&BaseParam{Protocol: "grpc", Mix: testMix()}, 1)
+	if err != nil {
+		t.Fatalf("newRequestBuilder: %v", err)
+	}
+
+	const samples = 20000
+	counts := make(map[any]int)
+	for i := 0; i < samples; i++ {
+		counts[builder.next().params["account_number"]]++
+	}
+
+	if share := float64(counts["A"]) / samples; math.Abs(share-0.75) > 0.02 {
+		t.Fatalf("share of A = %.3f, want 0.75 within 0.02", share)
+	}
+}
+
+func TestRequestBuilderSeed(t *testing.T) {
+	sequence := func(seed int64) []any {
+		builder, err := newRequestBuilder(&BaseParam{Protocol: "grpc", Mix: testMix()}, seed)
+		if err != nil {
+			t.Fatalf("newRequestBuilder: %v", err)
+		}
+
+		var sequence []any
+		for i := 0; i < 50; i++ {
+			sequence = append(sequence, builder.next().params["account_number"])
+		}
+		return sequence
+	}
+
+	a, b, c := sequence(42), sequence(42), sequence(43)
+	differs := false
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed gave different sequences at %d", i)
+		}
+		differs = differs || a[i] != c[i]
+	}
+	if !differs {
+		t.Fatal("different seeds gave the same sequence")
+	}
+}
+
+func TestRequestBuilderMessageIDs(t *testing.T) {
+	messageIDs := func(seed int64, nonce uint64) []string {
+		builder, err := newRequestBuilder(&BaseParam{
+			Protocol:       "bl2",
+			Payload:        Payload{AccountNumber: "A"},
+			MessageIDNonce: nonce,
+		}, seed)
+		if err != nil {
+			t.Fatalf("newRequestBuilder: %v", err)
+		}
+
+		var ids []string
+		for i := 0; i < 5; i++ {
+			ids = append(ids, builder.next().messageID)
+		}
+		return ids
+	}
+
+	tests := map[string]struct {
+		seed  int64
+		nonce uint64
+		same  bool
+	}{
+		"same seed":   {seed: 42, same: true},
+		"other seed":  {seed: 43},
+		"other nonce": {seed: 42, nonce: 1},
+	}
+
+	base := messageIDs(42, 0)
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ids := messageIDs(tt.seed, tt.nonce)
+			if got := slices.Equal(ids, base); got != tt.same {
+				t.Fatalf("ids = %v, base = %v, want equal %t", ids, base, tt.same)
+			}
+		})
+	}
+}
+
+func TestRequestBuilderWithoutMix(t *testing.T) {
+	builder, err := newRequestBuilder(&BaseParam{Protocol: "grpc", Payload: Payload{AccountNumber: "1001000000001"}}, 1)
+	if err != nil {
+		t.Fatalf("newRequestBuilder: %v", err)
+	}
+
+	req := builder.next()
+	if req.operation != OperationGetAccountByAccountNumber || req.params["account_number"] != "1001000000001" {
+		t.Fatalf("request = %+v, want an inquiry of the payload account", req)
+	}
+}
+
+func TestExpandTemplate(t *testing.T) {
+	builder, err := newRequestBuilder(&BaseParam{Protocol: "grpc", Mix: []OperationMix{{
+		Operation: OperationGetAccountByAccountNumber,
+		Weight:    1,
+		Payload: map[string]any{
+			"account_number": []any{"A", "B", "C"},
+			"reference":      "ref-{{seq}}",
+			"amount":         10.5,
+		},
+	}}}, 1)
+	if err != nil {
+		t.Fatalf("newRequestBuilder: %v", err)
+	}
+
+	for seq := 1; seq <= 20; seq++ {
+		params := builder.next().params
+
+		switch params["account_number"] {
+		case "A", "B", "C":
+		default:
+			t.Fatalf("account_number = %v, want one of the list", params["account_number"])
+		}
+		if want := "ref-" + strconv.Itoa(seq); params["reference"] != want {
+			t.Fatalf("reference = %v, want %s", params["reference"], want)
+		}
+		if params["amount"] != 10.5 {
+			t.Fatalf("amount = %v, want 10.5", params["amount"])
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"load-tester/adapter/docker_adapter"
+	"load-tester/util/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+// monitorInterval is the time between two resource samples
+const monitorInterval = time.Second
+
+// ServiceEfficiency reports the resources a service used during the measured
+// phase of a run
+type ServiceEfficiency struct {
+	Service               string  `json:"service"`
+	CPUCoreSeconds        float64 `json:"cpu_core_seconds"`
+	AverageCores          float64 `json:"average_cores"`
+	PeakMemoryMB          float64 `json:"peak_memory_mb"`
+	RequestsPerCoreSecond float64 `json:"requests_per_core_second"`  // Successful requests per CPU-core-second
+	PeakMemoryMBPer1kRPS  float64 `json:"peak_memory_mb_per_1k_rps"` // Peak memory divided by thousands of requests per second
+}
+
+// StackEfficiency sums the services of a stack. Every request goes through
+// each service of the stack, so the stack figures are the cost of a request
+// end to end.
+type StackEfficiency struct {
+	Stack                 string              `json:"stack"`
+	CPUCoreSeconds        float64             `json:"cpu_core_seconds"`
+	AverageCores          float64             `json:"average_cores"`
+	PeakMemoryMB          float64             `json:"peak_memory_mb"` // Sum of the service peaks
+	RequestsPerCoreSecond float64             `json:"requests_per_core_second"`
+	PeakMemoryMBPer1kRPS  float64             `json:"peak_memory_mb_per_1k_rps"`
+	RPSPerP99Ms           float64             `json:"rps_per_p99_ms"` // Successful requests per second divided by p99 latency, latency-normalized throughput
+	Services              []ServiceEfficiency `json:"services"`
+}
+
+// monitorResources periodically samples the load tester process and, when a
+// Docker socket is configured, the services of the stacks serving the
+// protocol of the test
+func (service *Service) monitorResources(ctx context.Context, rec *recorder) {
+	const op = "service/monitorResources"
+
+	stacks := service.monitoredStacks(rec.protocol)
+	rec.setStacks(stacks)
+
+	// Services that failed to sample are reported once
+	failed := make(map[string]bool)
+	sampleServices := func() {
+		for _, stack := range stacks {
+			for _, name := range stack.Services {
+				stats, err := service.adapter.dockerAdapter.GetContainerStats(ctx, name)
+				if err != nil {
+					if !failed[name] && ctx.Err() == nil {
+						failed[name] = true
+						service.logger.WithFields(logrus.Fields{
+							"op":      op,
+							"service": name,
+							"err":     err.Error(),
+						}).Warn("Failed to sample service resources")
+					}
+					continue
+				}
+
+				rec.addServiceSample(name, stats)
+			}
+		}
+	}
+
+	// First sample at the start so short tests get a CPU delta
+	sampleServices()
+
+	ticker := time.NewTicker(monitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// CPU Usage
+			if cpuPercent, err := service.proc.CPUPercent(); err == nil {
+				rec.addCPUSample(cpuPercent)
+			}
+
+			// Memory Usage
+			if memInfo, err := service.proc.MemoryInfo(); err == nil {
+				rec.addMemorySample(memInfo.RSS)
+			}
+
+			sampleServices()
+
+			// Force GC to get accurate memory stats
+			runtime.GC()
+		}
+	}
+}
+
+// monitoredStacks returns the stacks serving protocol, none without a Docker socket
+func (service *Service) monitoredStacks(protocol string) []config.Stack {
+	if service.adapter.dockerAdapter == nil {
+		return nil
+	}
+
+	var stacks []config.Stack
+	for _, stack := range service.config.ResourceMonitor.Stacks {
+		if stack.Protocol == protocol {
+			stacks = append(stacks, stack)
+		}
+	}
+
+	return stacks
+}
+
+// computeEfficiency derives the efficiency figures of each stack from the
+// service samples taken during the measured phase. Services with fewer than
+// two samples in the phase are left out.
+func computeEfficiency(stacks []config.Stack, samples map[string][]*docker_adapter.ContainerStats, metrics *Metrics) []StackEfficiency {
+	duration := metrics.EndTime.Sub(metrics.StartTime).Seconds()
+	if duration <= 0 {
+		return nil
+	}
+
+	total := float64(metrics.TotalRequests.Load())
+	successful := float64(metrics.SuccessfulRequests.Load())
+	averageRPS := total / duration
+	successfulRPS := successful / duration
+	p99Ms := calculatePercentileLatency(metrics.Latencies, 99)
+
+	// Samples just outside the phase still describe its edges
+	from := metrics.StartTime.Add(-monitorInterval)
+	to := metrics.EndTime.Add(monitorInterval)
+
+	var result []StackEfficiency
+	for _, stack := range stacks {
+		stackEfficiency := StackEfficiency{
+			Stack:    stack.Name,
+			Services: []ServiceEfficiency{},
+		}
+
+		for _, name := range stack.Services {
+			var first, last *docker_adapter.ContainerStats
+			var peakMemory uint64
+			for _, sample := range samples[name] {
+				if sample.Time.Before(from) || sample.Time.After(to) {
+					continue
+				}
+				if first == nil {
+					first = sample
+				}
+				last = sample
+				peakMemory = max(peakMemory, sample.MemoryBytes)
+			}
+
+			if first == nil || !last.Time.After(first.Time) || last.CPUUsageNs < first.CPUUsageNs {
+				continue
+			}
+
+			cores := float64(last.CPUUsageNs-first.CPUUsageNs) / 1e9 / last.Time.Sub(first.Time).Seconds()
+			serviceEfficiency := ServiceEfficiency{
+				Service:        name,
+				CPUCoreSeconds: cores * duration,
+				AverageCores:   cores,
+				PeakMemoryMB:   float64(peakMemory) / 1024 / 1024,
+			}
+			serviceEfficiency.RequestsPerCoreSecond = ratio(successful, serviceEfficiency.CPUCoreSeconds)
+			serviceEfficiency.PeakMemoryMBPer1kRPS = ratio(serviceEfficiency.PeakMemoryMB, averageRPS/1000)
+
+			stackEfficiency.CPUCoreSeconds += serviceEfficiency.CPUCoreSeconds
+			stackEfficiency.AverageCores += serviceEfficiency.AverageCores
+			stackEfficiency.PeakMemoryMB += serviceEfficiency.PeakMemoryMB
+			stackEfficiency.Services = append(stackEfficiency.Services, serviceEfficiency)
+		}
+
+		if len(stackEfficiency.Services) == 0 {
+			continue
+		}
+
+		stackEfficiency.RequestsPerCoreSecond = ratio(successful, stackEfficiency.CPUCoreSeconds)
+		stackEfficiency.PeakMemoryMBPer1kRPS = ratio(stackEfficiency.PeakMemoryMB, averageRPS/1000)
+		stackEfficiency.RPSPerP99Ms = ratio(successfulRPS, p99Ms)
+
+		result = append(result, stackEfficiency)
+	}
+
+	return result
+}
+
+// ratio divides a by b, 0 when b is not positive
+func ratio(a, b float64) float64 {
+	if b <= 0 {
+		return 0
+	}
+
+	return a / b
+}
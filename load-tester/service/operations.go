@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"load-tester/adapter/go_gateway_adapter"
+	"load-tester/util/assertion"
+)
+
+// Operations, named after their BL2 operation
+const (
+	OperationGetAccountByAccountNumber = "get_account_by_account_number"
+)
+
+// operationSpec describes an operation the load tester can send. BL2 requests
+// pass the operation name and params through to the gateway; gRPC requests
+// need a call to the matching go-gateway RPC.
+type operationSpec struct {
+	// required lists the params every payload must contain
+	required []string
+
+	// grpc calls the go-gateway RPC of the operation, nil if there is none
+	grpc func(ctx context.Context, adapter *adapter, params map[string]any) error
+}
+
+// operations is the registry of supported operations. Adding an operation
+// only needs an entry here.
+var operations = map[string]*operationSpec{
+	OperationGetAccountByAccountNumber: {
+		required: []string{"account_number"},
+		grpc:     grpcGetAccountByAccountNumber,
+	},
+}
+
+// supportedOperations returns the names of the registered operations
+func supportedOperations() []string {
+	names := make([]string, 0, len(operations))
+	for name := range operations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// lookupOperation returns the spec of operation if it can be sent over protocol
+func lookupOperation(operation string, protocol string) (*operationSpec, error) {
+	spec, ok := operations[operation]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operation: %s, must be one of %s", operation, strings.Join(supportedOperations(), ", "))
+	}
+
+	if protocol == "grpc" && spec.grpc == nil {
+		return nil, fmt.Errorf("operation %s has no grpc call", operation)
+	}
+
+	return spec, nil
+}
+
+func grpcGetAccountByAccountNumber(ctx context.Context, adapter *adapter, params map[string]any) error {
+	accountNumber, err := assertion.AssertMapValue[map[string]any, string, any, string](params, "account_number")
+	if err != nil {
+		return newValidationError("grpc", fmt.Errorf("invalid account_number: %v", err))
+	}
+
+	_, err = adapter.goGatewayAdapter.GetAccountByAccountNumber(ctx, &go_gateway_adapter.GetAccountByAccountNumberParams{
+		AccountNumber: accountNumber,
+	})
+
+	return err
+}
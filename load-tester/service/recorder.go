@@ -0,0 +1,349 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"load-tester/adapter/docker_adapter"
+	"load-tester/util/config"
+	"load-tester/util/errs"
+)
+
+// newMetrics creates empty metrics sized for the expected number of requests
+func newMetrics(expected int) *Metrics {
+	return &Metrics{
+		Latencies:   make([]time.Duration, 0, expected),
+		StartTime:   time.Now(),
+		CPUUsage:    make([]float64, 0),
+		MemoryUsage: make([]uint64, 0),
+		Errors:      make(ErrorBreakdown),
+	}
+}
+
+// recorder collects request outcomes of a run, keeping warmup requests apart
+// from the measured ones
+type recorder struct {
+	mutex    sync.Mutex
+	protocol string
+
+	measured *Metrics
+	warmup   *Metrics
+
+	// latency of the very first completed request, a cold-start indicator
+	firstLatency time.Duration
+
+	// timeline of measured requests, nil unless enabled
+	timeline *timeline
+
+	// measured requests per operation, nil unless enabled
+	operations map[string]*Metrics
+
+	// stacks whose services are sampled, and their samples by service
+	stacks   []config.Stack
+	services map[string][]*docker_adapter.ContainerStats
+}
+
+func newRecorder(protocol string, expected int, warmup *warmupPhase) *recorder {
+	rec := &recorder{
+		protocol: protocol,
+		measured: newMetrics(expected),
+	}
+
+	if warmup.enabled() {
+		rec.warmup = newMetrics(warmup.requests)
+	}
+
+	return rec
+}
+
+// enableTimeline groups measured requests into intervals starting when the
+// measured phase starts
+func (rec *recorder) enableTimeline(interval time.Duration) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.timeline = newTimeline(rec.measured.StartTime, interval)
+}
+
+// enableOperations keeps a breakdown of measured requests per operation
+func (rec *recorder) enableOperations() {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	if rec.operations == nil {
+		rec.operations = make(map[string]*Metrics)
+	}
+}
+
+// setStacks sets the stacks whose services are sampled during the run
+func (rec *recorder) setStacks(stacks []config.Stack) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.stacks = stacks
+	if rec.services == nil {
+		rec.services = make(map[string][]*docker_adapter.ContainerStats)
+	}
+}
+
+// addCPUSample stores a CPU usage sample of the load tester process
+func (rec *recorder) addCPUSample(percent float64) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.measured.CPUUsage = append(rec.measured.CPUUsage, percent)
+}
+
+// addMemorySample stores a memory usage sample of the load tester process
+func (rec *recorder) addMemorySample(rss uint64) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.measured.MemoryUsage = append(rec.measured.MemoryUsage, rss)
+}
+
+// addServiceSample stores a resource sample of a target service
+func (rec *recorder) addServiceSample(service string, stats *docker_adapter.ContainerStats) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.services[service] = append(rec.services[service], stats)
+}
+
+// startMeasuring marks the end of the warmup phase and resets the start time
+// of the measured phase
+func (rec *recorder) startMeasuring() {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.measured.StartTime = time.Now()
+	if rec.timeline != nil {
+		rec.timeline.origin = rec.measured.StartTime
+	}
+	for _, metrics := range rec.operations {
+		metrics.StartTime = rec.measured.StartTime
+	}
+}
+
+// record stores the outcome of a single request of operation sent at start
+func (rec *recorder) record(isWarmup bool, operation string, start time.Time, latency time.Duration, err error) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	metrics := rec.measured
+	if isWarmup && rec.warmup != nil {
+		metrics = rec.warmup
+		metrics.EndTime = time.Now()
+	}
+
+	// A request cut short by stopping the test says nothing about the
+	// target, it is only counted, like warmup requests are kept apart
+	if err != nil && classifyError(rec.protocol, err).Category == ErrorCategoryCanceled {
+		metrics.CanceledRequests.Add(1)
+		return
+	}
+
+	if rec.firstLatency == 0 {
+		rec.firstLatency = latency
+	}
+
+	metrics.add(rec.protocol, latency, err)
+
+	if isWarmup {
+		return
+	}
+
+	if rec.timeline != nil {
+		rec.timeline.add(start, latency, err)
+	}
+
+	if rec.operations != nil {
+		if operation == "" {
+			operation = "unknown"
+		}
+
+		opMetrics, ok := rec.operations[operation]
+		if !ok {
+			opMetrics = newMetrics(0)
+			opMetrics.StartTime = rec.measured.StartTime
+			rec.operations[operation] = opMetrics
+		}
+		opMetrics.add(rec.protocol, latency, err)
+	}
+}
+
+// recordClientDrop counts a request that was never sent because the
+// in-flight limit was reached
+func (rec *recorder) recordClientDrop(isWarmup bool) {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	metrics := rec.measured
+	if isWarmup && rec.warmup != nil {
+		metrics = rec.warmup
+	}
+
+	metrics.ClientDropped.Add(1)
+}
+
+// add counts a single request outcome
+func (metrics *Metrics) add(protocol string, latency time.Duration, err error) {
+	metrics.Latencies = append(metrics.Latencies, latency)
+	if err != nil {
+		metrics.recordError(protocol, err)
+	} else {
+		metrics.SuccessfulRequests.Add(1)
+	}
+	metrics.TotalRequests.Add(1)
+}
+
+// stopSending marks the end of the send window of the measured phase
+func (rec *recorder) stopSending() {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.measured.SendEndTime = time.Now()
+}
+
+// finish closes the measured phase
+func (rec *recorder) finish() {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	rec.measured.EndTime = time.Now()
+	for _, metrics := range rec.operations {
+		metrics.EndTime = rec.measured.EndTime
+	}
+}
+
+// result converts the recorded metrics into a test result
+func (rec *recorder) result() *TestResult {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	result := &TestResult{
+		Summary: summarize(rec.measured),
+		ResourceMetrics: ResourceMetric{
+			CPU: CPU{
+				AverageUsage: calculateAverage(rec.measured.CPUUsage),
+				PeakUsage:    calculatePeak(rec.measured.CPUUsage),
+				UsagePattern: rec.measured.CPUUsage,
+			},
+			Memory: Memory{
+				AverageMB: float64(calculateAverage(convertToFloat64(rec.measured.MemoryUsage))) / 1024 / 1024,
+				PeakMB:    float64(calculatePeak(convertToFloat64(rec.measured.MemoryUsage))) / 1024 / 1024,
+			},
+		},
+		Errors: rec.measured.Errors,
+	}
+
+	if rec.operations != nil {
+		result.Operations = make(map[string]*OperationResult, len(rec.operations))
+		for operation, metrics := range rec.operations {
+			result.Operations[operation] = &OperationResult{
+				Summary: summarize(metrics),
+				Errors:  metrics.Errors,
+			}
+		}
+	}
+
+	if len(rec.stacks) > 0 {
+		result.Efficiency = computeEfficiency(rec.stacks, rec.services, rec.measured)
+	}
+
+	if rec.warmup != nil {
+		result.Warmup = &WarmupResult{
+			Summary:               summarize(rec.warmup),
+			FirstRequestLatencyMs: fmt.Sprintf("%.2f", float64(rec.firstLatency.Microseconds())/1000),
+			Errors:                rec.warmup.Errors,
+		}
+	}
+
+	return result
+}
+
+// summarize builds the summary section of a result from metrics
+func summarize(metrics *Metrics) Summary {
+	duration := metrics.EndTime.Sub(metrics.StartTime)
+
+	averageRPS := 0.0
+	if duration > 0 {
+		averageRPS = float64(metrics.TotalRequests.Load()) / duration.Seconds()
+	}
+
+	return Summary{
+		TestDuration:       duration.String(),
+		TotalRequests:      fmt.Sprintf("%d", metrics.TotalRequests.Load()),
+		SuccessfulRequests: fmt.Sprintf("%d", metrics.SuccessfulRequests.Load()),
+		FailedRequests:     fmt.Sprintf("%d", metrics.FailedRequests.Load()),
+		TimeoutRequests:    fmt.Sprintf("%d", metrics.TimeoutRequests.Load()),
+		DroppedRequests:    fmt.Sprintf("%d", metrics.DroppedRequests.Load()),
+		ClientDropped:      fmt.Sprintf("%d", metrics.ClientDropped.Load()),
+		CanceledRequests:   fmt.Sprintf("%d", metrics.CanceledRequests.Load()),
+		AverageRPS:         fmt.Sprintf("%.2f", averageRPS),
+		P95LatencyMs:       fmt.Sprintf("%.2f", calculatePercentileLatency(metrics.Latencies, 95)),
+		P99LatencyMs:       fmt.Sprintf("%.2f", calculatePercentileLatency(metrics.Latencies, 99)),
+	}
+}
+
+// warmupPhase decides whether the next request still belongs to the warmup
+type warmupPhase struct {
+	requests int
+	duration time.Duration
+
+	launched int
+	until    time.Time
+}
+
+// newWarmupPhase parses the warmup parameter. A nil parameter disables warmup.
+func newWarmupPhase(param *Warmup) (*warmupPhase, error) {
+	const op errs.Op = "service/newWarmupPhase"
+
+	phase := &warmupPhase{}
+	if param == nil {
+		return phase, nil
+	}
+
+	if param.Requests < 0 {
+		return nil, errs.E(op, errs.Validation, "warmup.requests must not be negative")
+	}
+	phase.requests = param.Requests
+
+	if param.Duration != "" {
+		duration, err := time.ParseDuration(param.Duration)
+		if err != nil {
+			return nil, errs.E(op, errs.Validation, fmt.Sprintf("invalid warmup.duration: %v", err))
+		}
+		if duration < 0 {
+			return nil, errs.E(op, errs.Validation, "warmup.duration must not be negative")
+		}
+		phase.duration = duration
+	}
+
+	return phase, nil
+}
+
+func (phase *warmupPhase) enabled() bool {
+	return phase.requests > 0 || phase.duration > 0
+}
+
+// next reports whether the request about to be launched is a warmup request.
+// The warmup lasts until both the request count and the duration are reached.
+func (phase *warmupPhase) next() bool {
+	if !phase.enabled() {
+		return false
+	}
+
+	now := time.Now()
+	if phase.until.IsZero() {
+		phase.until = now.Add(phase.duration)
+	}
+
+	if phase.launched < phase.requests || now.Before(phase.until) {
+		phase.launched++
+		return true
+	}
+
+	return false
+}
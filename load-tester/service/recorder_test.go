@@ -0,0 +1,116 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewWarmupPhase(t *testing.T) {
+	tests := map[string]struct {
+		param   *Warmup
+		wantErr bool
+		enabled bool
+	}{
+		"nil":               {param: nil},
+		"empty":             {param: &Warmup{}},
+		"requests":          {param: &Warmup{Requests: 10}, enabled: true},
+		"duration":          {param: &Warmup{Duration: "2s"}, enabled: true},
+		"negative requests": {param: &Warmup{Requests: -1}, wantErr: true},
+		"negative duration": {param: &Warmup{Duration: "-1s"}, wantErr: true},
+		"invalid duration":  {param: &Warmup{Duration: "soon"}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			phase, err := newWarmupPhase(tt.param)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newWarmupPhase: %v", err)
+			}
+			if phase.enabled() != tt.enabled {
+				t.Fatalf("enabled = %t, want %t", phase.enabled(), tt.enabled)
+			}
+		})
+	}
+}
+
+func TestWarmupPhaseRequests(t *testing.T) {
+	phase, err := newWarmupPhase(&Warmup{Requests: 3})
+	if err != nil {
+		t.Fatalf("newWarmupPhase: %v", err)
+	}
+
+	var got []bool
+	for i := 0; i < 5; i++ {
+		got = append(got, phase.next())
+	}
+
+	want := []bool{true, true, true, false, false}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("next() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestWarmupPhaseDuration(t *testing.T) {
+	// The warmup lasts until both the request count and the duration are reached
+	phase, err := newWarmupPhase(&Warmup{Requests: 1, Duration: "50ms"})
+	if err != nil {
+		t.Fatalf("newWarmupPhase: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if !phase.next() {
+			t.Fatalf("request %d left the warmup before its duration", i)
+		}
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if phase.next() {
+		t.Fatal("request after the warmup duration still counted as warmup")
+	}
+}
+
+func TestWarmupPhaseDisabled(t *testing.T) {
+	phase, err := newWarmupPhase(nil)
+	if err != nil {
+		t.Fatalf("newWarmupPhase: %v", err)
+	}
+
+	if phase.next() {
+		t.Fatal("disabled warmup counted a request")
+	}
+}
+
+func TestRecorderKeepsWarmupApart(t *testing.T) {
+	warmup, err := newWarmupPhase(&Warmup{Requests: 2})
+	if err != nil {
+		t.Fatalf("newWarmupPhase: %v", err)
+	}
+	rec := newRecorder("grpc", 4, warmup)
+
+	rec.record(true, "", time.Now(), 50*time.Millisecond, nil)
+	rec.record(true, "", time.Now(), 40*time.Millisecond, errors.New("connection reset"))
+	rec.record(false, "", time.Now(), time.Millisecond, nil)
+
+	if got := rec.warmup.TotalRequests.Load(); got != 2 {
+		t.Fatalf("warmup requests = %d, want 2", got)
+	}
+	if got := rec.measured.TotalRequests.Load(); got != 1 {
+		t.Fatalf("measured requests = %d, want 1", got)
+	}
+	if got := rec.measured.FailedRequests.Load(); got != 0 {
+		t.Fatalf("measured failures = %d, want 0", got)
+	}
+	if rec.firstLatency != 50*time.Millisecond {
+		t.Fatalf("first latency = %s, want 50ms", rec.firstLatency)
+	}
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"load-tester/store/file_store"
+	"load-tester/util/errs"
+	"load-tester/util/stats"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// maxLatencySamples caps the number of latencies stored with a run
+const maxLatencySamples = 10000
+
+type GetRunParam struct {
+	ID string `json:"id"`
+}
+
+type ListRunsParam struct {
+	Mode        string   `json:"mode"`
+	ServiceName string   `json:"service_name"`
+	Protocol    string   `json:"protocol"`
+	Profile     string   `json:"profile"`
+	Tags        []string `json:"tags"`  // Runs must have every tag
+	Query       string   `json:"query"` // Text searched in the description, tags and environment
+}
+
+type SetBaselineParam struct {
+	ID string `json:"id"`
+}
+
+type CompareRunParam struct {
+	CompareParam
+	ID         string `json:"id"`
+	BaselineID string `json:"baseline_id"` // Defaults to the baseline of the run's target/protocol/profile
+}
+
+// RunSummary is the short form of a stored run used in listings
+type RunSummary struct {
+	ID          string   `json:"id"`
+	Mode        string   `json:"mode"`
+	ServiceName string   `json:"service_name"`
+	Protocol    string   `json:"protocol"`
+	Profile     string   `json:"profile"`
+	Tags        []string `json:"tags,omitempty"`
+	Description string   `json:"description,omitempty"`
+	StartedAt   string   `json:"started_at"`
+	AverageRPS  float64  `json:"average_rps"`
+	IsBaseline  bool     `json:"is_baseline"`
+}
+
+// finishRun stores the run with its environment and compares it with the
+// baseline of its target/protocol/profile, if any. Storage failures are
+// logged but do not fail the test.
+func (service *Service) finishRun(ctx context.Context, mode string, base *BaseParam, param any, rec *recorder, result any) map[string]any {
+	const op errs.Op = "service/finishRun"
+
+	response := map[string]any{
+		"result": result,
+	}
+
+	// The seed reissues the same request sequence
+	if base.Seed != nil {
+		response["seed"] = *base.Seed
+	}
+	if base.MessageIDNonce != 0 {
+		response["message_id_nonce"] = base.MessageIDNonce
+	}
+
+	env := service.captureEnvironment(ctx)
+	response["environment"] = env
+
+	run, err := newRun(mode, base, param, rec, result, env)
+	if err != nil {
+		service.logger.WithFields(logrus.Fields{
+			"op":  op,
+			"err": err.Error(),
+		}).Error("Failed to build run")
+
+		return response
+	}
+
+	if err := service.store.file.SaveRun(ctx, run); err != nil {
+		service.logger.WithFields(logrus.Fields{
+			"op":  op,
+			"err": err.Error(),
+		}).Error("Failed to save run")
+
+		return response
+	}
+	response["run_id"] = run.ID
+
+	baselineID, err := service.store.file.GetBaseline(ctx, run.Key())
+	if err != nil {
+		if !errors.Is(err, file_store.ErrNotFound) {
+			service.logger.WithFields(logrus.Fields{
+				"op":  op,
+				"err": err.Error(),
+			}).Error("Failed to get baseline")
+		}
+
+		return response
+	}
+
+	baseline, err := service.store.file.GetRun(ctx, baselineID)
+	if err != nil {
+		service.logger.WithFields(logrus.Fields{
+			"op":          op,
+			"baseline_id": baselineID,
+			"err":         err.Error(),
+		}).Error("Failed to get baseline run")
+
+		return response
+	}
+
+	response["comparison"] = compareRuns(baseline, run, CompareParam{})
+
+	return response
+}
+
+// newRun builds the stored form of a finished run
+func newRun(mode string, base *BaseParam, param any, rec *recorder, result any, env *Environment) (*file_store.Run, error) {
+	paramBytes, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	envBytes, err := json.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := base.Profile
+	if profile == "" {
+		profile = mode
+	}
+
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	metrics := rec.measured
+
+	// The latency sample is drawn with the run seed, so a reissued run
+	// stores the same sample of the same latencies
+	var seed uint64
+	if base.Seed != nil {
+		seed = uint64(*base.Seed)
+	}
+
+	run := &file_store.Run{
+		ID:          uuid.New().String(),
+		Mode:        mode,
+		ServiceName: base.ServiceName,
+		Protocol:    base.Protocol,
+		Profile:     profile,
+		Tags:        base.Tags,
+		Description: base.Description,
+		Seed:        base.Seed,
+		StartedAt:   metrics.StartTime,
+		FinishedAt:  metrics.EndTime,
+		Param:       paramBytes,
+		Result:      resultBytes,
+		Environment: envBytes,
+		Stats: file_store.RunStats{
+			TotalRequests:   metrics.TotalRequests.Load(),
+			FailedRequests:  metrics.TotalRequests.Load() - metrics.SuccessfulRequests.Load(),
+			LatencySampleMs: sampleLatencies(metrics.Latencies, maxLatencySamples, seed),
+		},
+	}
+
+	if duration := metrics.EndTime.Sub(metrics.StartTime); duration > 0 {
+		run.Stats.AverageRPS = float64(metrics.TotalRequests.Load()) / duration.Seconds()
+	}
+
+	return run, nil
+}
+
+// sampleLatencies returns up to limit latencies in milliseconds drawn at
+// random, so the stored sample is an i.i.d. subsample of the run
+func sampleLatencies(latencies []time.Duration, limit int, seed uint64) []float64 {
+	values := make([]float64, len(latencies))
+	for i, latency := range latencies {
+		values[i] = latencyMs(latency)
+	}
+
+	return stats.Sample(values, limit, seed)
+}
+
+// GetRun returns a stored run
+func (service *Service) GetRun(ctx context.Context, param *GetRunParam) (*file_store.Run, error) {
+	const op errs.Op = "service/GetRun"
+
+	run, err := service.store.file.GetRun(ctx, param.ID)
+	if errors.Is(err, file_store.ErrNotFound) {
+		return nil, errs.E(op, errs.NotExist, fmt.Sprintf("run %s not found", param.ID))
+	}
+	if err != nil {
+		return nil, errs.E(op, errs.IO, err)
+	}
+
+	return run, nil
+}
+
+// ListRuns returns the stored runs matching param, newest first
+func (service *Service) ListRuns(ctx context.Context, param *ListRunsParam) ([]RunSummary, error) {
+	const op errs.Op = "service/ListRuns"
+
+	runs, err := service.store.file.ListRuns(ctx, &file_store.RunFilter{
+		Mode:        param.Mode,
+		ServiceName: param.ServiceName,
+		Protocol:    param.Protocol,
+		Profile:     param.Profile,
+		Tags:        param.Tags,
+		Query:       param.Query,
+	})
+	if err != nil {
+		return nil, errs.E(op, errs.IO, err)
+	}
+
+	baselines := make(map[string]string)
+	summaries := make([]RunSummary, 0, len(runs))
+	for _, run := range runs {
+		baselineID, ok := baselines[run.Key()]
+		if !ok {
+			baselineID, _ = service.store.file.GetBaseline(ctx, run.Key())
+			baselines[run.Key()] = baselineID
+		}
+
+		summaries = append(summaries, RunSummary{
+			ID:          run.ID,
+			Mode:        run.Mode,
+			ServiceName: run.ServiceName,
+			Protocol:    run.Protocol,
+			Profile:     run.Profile,
+			Tags:        run.Tags,
+			Description: run.Description,
+			StartedAt:   run.StartedAt.Format(time.RFC3339),
+			AverageRPS:  run.AverageRPS,
+			IsBaseline:  baselineID == run.ID,
+		})
+	}
+
+	return summaries, nil
+}
+
+// SetBaseline marks a run as the baseline for its target/protocol/profile
+func (service *Service) SetBaseline(ctx context.Context, param *SetBaselineParam) (map[string]any, error) {
+	const op errs.Op = "service/SetBaseline"
+
+	run, err := service.GetRun(ctx, &GetRunParam{ID: param.ID})
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	if err := service.store.file.SetBaseline(ctx, run.Key(), run.ID); err != nil {
+		return nil, errs.E(op, errs.IO, err)
+	}
+
+	return map[string]any{
+		"key":    run.Key(),
+		"run_id": run.ID,
+	}, nil
+}
+
+// CompareRun compares a stored run with a baseline run
+func (service *Service) CompareRun(ctx context.Context, param *CompareRunParam) (*Comparison, error) {
+	const op errs.Op = "service/CompareRun"
+
+	run, err := service.GetRun(ctx, &GetRunParam{ID: param.ID})
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	baselineID := param.BaselineID
+	if baselineID == "" {
+		baselineID, err = service.store.file.GetBaseline(ctx, run.Key())
+		if errors.Is(err, file_store.ErrNotFound) {
+			return nil, errs.E(op, errs.NotExist, fmt.Sprintf("no baseline set for %s", run.Key()))
+		}
+		if err != nil {
+			return nil, errs.E(op, errs.IO, err)
+		}
+	}
+
+	baseline, err := service.GetRun(ctx, &GetRunParam{ID: baselineID})
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	return compareRuns(baseline, run, param.CompareParam), nil
+}
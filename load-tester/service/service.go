@@ -5,18 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"runtime"
-	"sync"
 	"time"
 
+	"load-tester/adapter/docker_adapter"
 	"load-tester/adapter/go_gateway_adapter"
 	"load-tester/adapter/py_gateway_adapter"
+	"load-tester/store/file_store"
+	"load-tester/util/assertion"
+	"load-tester/util/config"
 
 	"github.com/google/uuid"
 	"github.com/shirou/gopsutil/v3/process"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // Custom error types for timeout and dropped requests
@@ -29,163 +29,127 @@ var (
 type adapter struct {
 	goGatewayAdapter *go_gateway_adapter.Adapter
 	pyGatewayAdapter *py_gateway_adapter.Adapter
+	dockerAdapter    *docker_adapter.Adapter // nil when per-service sampling is disabled
+}
+
+// service store
+type store struct {
+	file file_store.IStore
 }
 
 // service
 type Service struct {
 	logger *logrus.Logger
+	config config.Config
 
 	adapter *adapter
+	store   *store
 
-	metricsLock sync.Mutex
-	proc        *process.Process // Current process info for resource monitoring
+	proc *process.Process // Current process info for resource monitoring
 }
 
 func NewService(
 	logger *logrus.Logger,
+	config config.Config,
 	goGatewayAdapter *go_gateway_adapter.Adapter,
 	pyGatewayAdapter *py_gateway_adapter.Adapter,
+	dockerAdapter *docker_adapter.Adapter,
+	fileStore file_store.IStore,
 ) *Service {
 	proc, _ := process.NewProcess(int32(os.Getpid()))
 
 	return &Service{
 		logger: logger,
+		config: config,
 
 		adapter: &adapter{
 			goGatewayAdapter: goGatewayAdapter,
 			pyGatewayAdapter: pyGatewayAdapter,
+			dockerAdapter:    dockerAdapter,
+		},
+		store: &store{
+			file: fileStore,
 		},
 
 		proc: proc,
 	}
 }
 
-// monitorResources periodically collects resource usage metrics
-func (service *Service) monitorResources(ctx context.Context, metrics *Metrics) {
-	ticker := time.NewTicker(time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			// CPU Usage
-			if cpuPercent, err := service.proc.CPUPercent(); err == nil {
-				service.metricsLock.Lock()
-				metrics.CPUUsage = append(metrics.CPUUsage, cpuPercent)
-				service.metricsLock.Unlock()
-			}
-
-			// Memory Usage
-			if memInfo, err := service.proc.MemoryInfo(); err == nil {
-				service.metricsLock.Lock()
-				metrics.MemoryUsage = append(metrics.MemoryUsage, memInfo.RSS)
-				service.metricsLock.Unlock()
-			}
-
-			// Force GC to get accurate memory stats
-			runtime.GC()
-		}
-	}
-}
-
-// preparePayload creates a copy of the payload
-func (service *Service) preparePayload(param *BaseParam) (map[string]any, error) {
+// newMessageID creates a unique BL2 id_message
+func newMessageID() string {
 	now := time.Now()
 	timestamp := now.Format("20060102150405")
 	nanoID := fmt.Sprintf("%d", now.UnixNano()%100000000000)
 
-	// Start with the required fields
-	payload := map[string]any{}
-
-	if param.Protocol == "bl2" {
-		// TCP protocol format for py-core
-		payload["id_message"] = timestamp + nanoID + uuid.New().String()
-		payload["operation"] = "get_account_by_account_number"
-		payload["params"] = map[string]any{
-			"account_number": param.Payload.AccountNumber,
-		}
-	} else {
-		// gRPC protocol - just the account number
-		payload["account_number"] = param.Payload.AccountNumber
-	}
-
-	return payload, nil
+	return timestamp + nanoID + uuid.New().String()
 }
 
-// executeRequest executes a request based on the protocol
-func (service *Service) executeRequest(ctx context.Context, param *BaseParam) error {
+// executeRequest sends a request through adapter based on its protocol.
+// Failures are returned as *RequestError so they can be counted by category.
+func (service *Service) executeRequest(ctx context.Context, adapter *adapter, req *request) error {
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
+		return classifyError(req.protocol, ctx.Err())
 	default:
-		// Create payload with unique system_trace_audit
-		payload, err := service.preparePayload(param)
-		if err != nil {
-			return fmt.Errorf("failed to prepare payload: %v", err)
-		}
-		payloadBytes, err := json.Marshal(payload)
-		if err != nil {
-			return fmt.Errorf("failed to marshal payload: %v", err)
-		}
-
 		// Execute request based on protocol
-		switch param.Protocol {
+		switch req.protocol {
 		case "bl2":
-			respMap, err := service.adapter.pyGatewayAdapter.SendRequest(payloadBytes)
+			// TCP protocol format for py-gateway, with a unique id_message
+			messageID := req.messageID
+			if messageID == "" {
+				messageID = newMessageID()
+			}
+
+			payloadBytes, err := json.Marshal(map[string]any{
+				"id_message": messageID,
+				"operation":  req.operation,
+				"params":     req.params,
+			})
 			if err != nil {
-				return err
+				return newValidationError(req.protocol, fmt.Errorf("failed to marshal payload: %v", err))
 			}
 
-			// Check err_info for timeout and dropped cases
-			if errInfo, ok := respMap["err_info"].(string); ok {
-				if errInfo == "timeout" {
-					return ErrTimeout
-				}
-				if errInfo == "dropped" {
-					return ErrDropped
-				}
+			respMap, err := adapter.pyGatewayAdapter.SendRequest(payloadBytes)
+			if err != nil {
+				return classifyError(req.protocol, err)
 			}
 
 			// Check response status
-			if status, ok := respMap["status"].(string); ok && status != "000" {
-				responseCode := "unknown"
-				responseMsg := "unknown error"
+			statusCode, err := assertion.AssertMapValue[map[string]any, string, any, string](respMap, "status")
+			if err != nil {
+				return newAssertionError(req.protocol, fmt.Errorf("invalid response status: %v", err))
+			}
 
-				if code, ok := respMap["response_code"].(string); ok {
-					responseCode = code
-				}
-				if msg, ok := respMap["response_msg"].(string); ok {
-					responseMsg = msg
+			if statusCode != bl2StatusSuccess {
+				errInfo, _ := respMap["err_info"].(string)
+				if errInfo == "" {
+					errInfo, _ = respMap["response_msg"].(string)
 				}
 
-				return fmt.Errorf("request failed with code %s: %s", responseCode, responseMsg)
+				return newBL2Error(statusCode, errInfo)
 			}
 
 			return nil
 
 		case "grpc":
-			_, err := service.adapter.goGatewayAdapter.GetAccountByAccountNumber(context.TODO(), &go_gateway_adapter.GetAccountByAccountNumberParams{
-				AccountNumber: payload["account_number"].(string),
-			})
-			if err != nil {
-				// Check for specific gRPC error codes
-				if st, ok := status.FromError(err); ok {
-					switch st.Code() {
-					case codes.DeadlineExceeded:
-						return ErrTimeout
-					case codes.Aborted:
-						return ErrDropped
-					}
-				}
-				return err
-			}
-
-			return nil
+			return service.executeGRPCRequest(ctx, adapter, req)
 
 		default:
-			return fmt.Errorf("unsupported protocol: %s", param.Protocol)
+			return newValidationError(req.protocol, fmt.Errorf("unsupported protocol: %s", req.protocol))
 		}
 	}
 }
+
+// executeGRPCRequest calls the go-gateway RPC matching the request operation
+func (service *Service) executeGRPCRequest(ctx context.Context, adapter *adapter, req *request) error {
+	spec, err := lookupOperation(req.operation, req.protocol)
+	if err != nil {
+		return newValidationError(req.protocol, err)
+	}
+
+	if err := spec.grpc(ctx, adapter, req.params); err != nil {
+		return classifyError(req.protocol, err)
+	}
+
+	return nil
+}
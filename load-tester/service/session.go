@@ -0,0 +1,107 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"load-tester/adapter/go_gateway_adapter"
+	"load-tester/util/errs"
+
+	"github.com/sirupsen/logrus"
+)
+
+// session holds what a test needs to send requests: the adapters, which may
+// include a dedicated gRPC client, the request builder and the seed of its
+// random choices
+type session struct {
+	adapter *adapter
+	builder *requestBuilder
+	seed    int64
+
+	close func()
+}
+
+// newSession prepares a test. Without a seed in param a random one is picked
+// and set in param, so the stored run can be reissued with the same requests.
+// The caller must close the session.
+func (service *Service) newSession(param *BaseParam) (*session, error) {
+	const op errs.Op = "service/newSession"
+
+	if param.Seed == nil {
+		seed := time.Now().UnixNano()
+		param.Seed = &seed
+	}
+
+	builder, err := newRequestBuilder(param, *param.Seed)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	s := &session{
+		adapter: service.adapter,
+		builder: builder,
+		seed:    *param.Seed,
+		close:   func() {},
+	}
+
+	if param.GRPCClient == nil {
+		return s, nil
+	}
+
+	// With grpc_client settings a dedicated gRPC client is created
+	options, err := newClientOptions(param.GRPCClient)
+	if err != nil {
+		return nil, errs.E(op, err)
+	}
+
+	goGatewayAdapter, err := service.adapter.goGatewayAdapter.WithOptions(options)
+	if err != nil {
+		return nil, errs.E(op, errs.Validation, err)
+	}
+
+	s.adapter = &adapter{
+		goGatewayAdapter: goGatewayAdapter,
+		pyGatewayAdapter: service.adapter.pyGatewayAdapter,
+		dockerAdapter:    service.adapter.dockerAdapter,
+	}
+
+	s.close = func() {
+		if err := goGatewayAdapter.Close(); err != nil {
+			service.logger.WithFields(logrus.Fields{
+				"op":  op,
+				"err": err.Error(),
+			}).Error("Failed to close gRPC client")
+		}
+	}
+
+	return s, nil
+}
+
+// newClientOptions parses the gRPC client settings of a test
+func newClientOptions(param *GRPCClient) (*go_gateway_adapter.ClientOptions, error) {
+	const op errs.Op = "service/newClientOptions"
+
+	options := &go_gateway_adapter.ClientOptions{
+		SubConnections:        param.SubConnections,
+		LoadBalancingPolicy:   param.LoadBalancingPolicy,
+		KeepaliveWithoutCalls: param.KeepaliveWithoutCalls,
+		InitialWindowSize:     param.InitialWindowSize,
+		InitialConnWindowSize: param.InitialConnWindowSize,
+		MaxConcurrentStreams:  param.MaxConcurrentStreams,
+		Compression:           param.Compression,
+	}
+
+	var err error
+	if param.KeepaliveTime != "" {
+		if options.KeepaliveTime, err = time.ParseDuration(param.KeepaliveTime); err != nil {
+			return nil, errs.E(op, errs.Validation, fmt.Sprintf("invalid grpc_client.keepalive_time: %v", err))
+		}
+	}
+	if param.KeepaliveTimeout != "" {
+		if options.KeepaliveTimeout, err = time.ParseDuration(param.KeepaliveTimeout); err != nil {
+			return nil, errs.E(op, errs.Validation, fmt.Sprintf("invalid grpc_client.keepalive_timeout: %v", err))
+		}
+	}
+
+	return options, nil
+}
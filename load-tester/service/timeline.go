@@ -0,0 +1,99 @@
+package service
+
+import (
+	"time"
+)
+
+// timelineBucket holds the outcomes of the requests sent during one interval
+type timelineBucket struct {
+	requests  int64
+	errors    int64
+	latencies []time.Duration
+}
+
+// timeline groups request outcomes by the interval in which they were sent
+type timeline struct {
+	origin   time.Time
+	interval time.Duration
+	buckets  []*timelineBucket
+}
+
+func newTimeline(origin time.Time, interval time.Duration) *timeline {
+	return &timeline{
+		origin:   origin,
+		interval: interval,
+	}
+}
+
+// add records a request sent at start
+func (tl *timeline) add(start time.Time, latency time.Duration, err error) {
+	offset := start.Sub(tl.origin)
+	if offset < 0 {
+		return
+	}
+
+	index := int(offset / tl.interval)
+	for len(tl.buckets) <= index {
+		tl.buckets = append(tl.buckets, &timelineBucket{})
+	}
+
+	bucket := tl.buckets[index]
+	bucket.requests++
+	bucket.latencies = append(bucket.latencies, latency)
+	if err != nil {
+		bucket.errors++
+	}
+}
+
+// window merges the buckets sent between from and to, relative to the origin
+func (tl *timeline) window(from, to time.Duration) *timelineBucket {
+	merged := &timelineBucket{}
+
+	for i, bucket := range tl.buckets {
+		offset := time.Duration(i) * tl.interval
+		if offset < from || offset >= to {
+			continue
+		}
+
+		merged.requests += bucket.requests
+		merged.errors += bucket.errors
+		merged.latencies = append(merged.latencies, bucket.latencies...)
+	}
+
+	return merged
+}
+
+// errorRate returns the share of failed requests in the bucket
+func (bucket *timelineBucket) errorRate() float64 {
+	if bucket.requests == 0 {
+		return 0
+	}
+
+	return float64(bucket.errors) / float64(bucket.requests)
+}
+
+// TimelinePoint is the reported form of a timeline bucket
+type TimelinePoint struct {
+	OffsetSeconds float64 `json:"offset_seconds"`
+	Requests      int64   `json:"requests"`
+	Errors        int64   `json:"errors"`
+	ErrorRate     float64 `json:"error_rate"`
+	P95LatencyMs  float64 `json:"p95_latency_ms"`
+}
+
+// points converts the timeline into its reported form
+func (tl *timeline) points() []TimelinePoint {
+	points := make([]TimelinePoint, 0, len(tl.buckets))
+
+	for i, bucket := range tl.buckets {
+		points = append(points, TimelinePoint{
+			OffsetSeconds: (time.Duration(i) * tl.interval).Seconds(),
+			Requests:      bucket.requests,
+			Errors:        bucket.errors,
+			ErrorRate:     bucket.errorRate(),
+			P95LatencyMs:  calculatePercentileLatency(bucket.latencies, 95),
+		})
+	}
+
+	return points
+}
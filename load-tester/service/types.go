@@ -12,18 +12,78 @@ type Metrics struct {
 	FailedRequests     atomic.Int64
 	TimeoutRequests    atomic.Int64 // Requests that timed out
 	DroppedRequests    atomic.Int64 // Requests rejected by queue
+	ClientDropped      atomic.Int64 // Requests never sent because max_in_flight was reached
+	CanceledRequests   atomic.Int64 // Requests cut short by stopping the test, left out of the other figures
 	Latencies          []time.Duration
 	StartTime          time.Time
 	EndTime            time.Time
+	SendEndTime        time.Time // When sending stopped, EndTime also covers draining the in-flight requests
 	CPUUsage           []float64
 	MemoryUsage        []uint64
-	Errors             map[string]int
+	Errors             ErrorBreakdown
+}
+
+// recordError classifies err, adds it to the error breakdown and bumps the
+// matching failure counter
+func (metrics *Metrics) recordError(protocol string, err error) {
+	reqErr := classifyError(protocol, err)
+	metrics.Errors.add(reqErr)
+
+	switch reqErr.Category {
+	case ErrorCategoryTimeout:
+		metrics.TimeoutRequests.Add(1)
+	case ErrorCategoryDropped:
+		metrics.DroppedRequests.Add(1)
+	default:
+		metrics.FailedRequests.Add(1)
+	}
+}
+
+// request is a single request to send to a target
+type request struct {
+	protocol  string
+	operation string
+	params    map[string]any // Operation parameters, e.g. account_number
+	messageID string         // BL2 id_message, generated when sent if empty
 }
 
 type BaseParam struct {
-	ServiceName string  `json:"service_name"`
-	Protocol    string  `json:"protocol"` // "bl2" or "grpc"
-	Payload     Payload `json:"payload"`
+	ServiceName    string         `json:"service_name"`
+	Protocol       string         `json:"protocol"` // "bl2" or "grpc"
+	Payload        Payload        `json:"payload"`
+	Mix            []OperationMix `json:"mix,omitempty"`              // Weighted operations, replaces payload when set
+	Profile        string         `json:"profile"`                    // Groups comparable runs, defaults to the test mode
+	Tags           []string       `json:"tags,omitempty"`             // Free-form labels stored with the run, e.g. a commit
+	Description    string         `json:"description,omitempty"`      // Free-form notes stored with the run
+	Warmup         *Warmup        `json:"warmup,omitempty"`           // Optional warmup excluded from the statistics
+	Seed           *int64         `json:"seed,omitempty"`             // Seeds payload selection, arrival jitter and message IDs, random if unset
+	MessageIDNonce uint64         `json:"message_id_nonce,omitempty"` // Mixed into the message IDs, gives runs with the same seed sharing a connection distinct IDs
+
+	MaxInFlight    int    `json:"max_in_flight"`    // Maximum concurrent requests, 0 means unbounded
+	InFlightPolicy string `json:"in_flight_policy"` // "block" (default) or "drop" when max_in_flight is reached
+
+	GRPCClient *GRPCClient `json:"grpc_client,omitempty"` // Optional gRPC client settings, a dedicated client is created for the test
+}
+
+// GRPCClient configures the gRPC client used by a test. Unset fields keep the
+// grpc defaults.
+type GRPCClient struct {
+	SubConnections        int    `json:"sub_connections"`          // Connections used round-robin, defaults to 1
+	LoadBalancingPolicy   string `json:"load_balancing_policy"`    // "pick_first" or "round_robin"
+	KeepaliveTime         string `json:"keepalive_time"`           // e.g. "30s", disabled if unset
+	KeepaliveTimeout      string `json:"keepalive_timeout"`        // e.g. "10s"
+	KeepaliveWithoutCalls bool   `json:"keepalive_without_calls"`  // Ping even without active calls
+	InitialWindowSize     int32  `json:"initial_window_size"`      // Per-stream window in bytes, at least 65535
+	InitialConnWindowSize int32  `json:"initial_conn_window_size"` // Per-connection window in bytes, at least 65535
+	MaxConcurrentStreams  int    `json:"max_concurrent_streams"`   // Calls in flight per connection, unbounded if 0
+	Compression           string `json:"compression"`              // "gzip" or "none"
+}
+
+// Warmup defines the start of a run that is sent but left out of the summary.
+// When both fields are set, the warmup lasts until both are reached.
+type Warmup struct {
+	Duration string `json:"duration"` // e.g. "5s"
+	Requests int    `json:"requests"` // Number of warmup requests
 }
 
 type Payload struct {
@@ -35,8 +95,10 @@ type Summary struct {
 	TotalRequests      string `json:"total_requests"`
 	SuccessfulRequests string `json:"successful_requests"`
 	FailedRequests     string `json:"failed_requests"`
-	TimeoutRequests    string `json:"timeout_requests"` // Requests that timed out
-	DroppedRequests    string `json:"dropped_requests"` // Requests rejected by queue
+	TimeoutRequests    string `json:"timeout_requests"`        // Requests that timed out
+	DroppedRequests    string `json:"dropped_requests"`        // Requests rejected by queue
+	ClientDropped      string `json:"client_dropped_requests"` // Requests dropped by the load tester
+	CanceledRequests   string `json:"canceled_requests"`       // Requests cut short by stopping the test
 	AverageRPS         string `json:"average_rps"`
 	P95LatencyMs       string `json:"p95_latency_ms"`
 	P99LatencyMs       string `json:"p99_latency_ms"`
@@ -58,8 +120,24 @@ type ResourceMetric struct {
 	Memory Memory `json:"memory"`
 }
 
+// WarmupResult reports the warmup requests separately to compare cold starts
+type WarmupResult struct {
+	Summary               Summary        `json:"summary"`
+	FirstRequestLatencyMs string         `json:"first_request_latency_ms"`
+	Errors                ErrorBreakdown `json:"errors"`
+}
+
+// OperationResult reports the measured requests of a single operation
+type OperationResult struct {
+	Summary Summary        `json:"summary"`
+	Errors  ErrorBreakdown `json:"errors"`
+}
+
 type TestResult struct {
-	Summary         Summary        `json:"summary"`
-	ResourceMetrics ResourceMetric `json:"resource_metric"`
-	Errors          map[string]int `json:"errors"`
+	Summary         Summary                     `json:"summary"`
+	ResourceMetrics ResourceMetric              `json:"resource_metric"`
+	Errors          ErrorBreakdown              `json:"errors"`
+	Operations      map[string]*OperationResult `json:"operations,omitempty"`
+	Warmup          *WarmupResult               `json:"warmup,omitempty"`
+	Efficiency      []StackEfficiency           `json:"efficiency,omitempty"` // Per-stack resource cost, empty without a Docker socket
 }
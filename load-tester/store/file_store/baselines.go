@@ -0,0 +1,63 @@
+package file_store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const baselinesFile = "baselines.json"
+
+// SetBaseline marks runID as the baseline for key
+func (store *Store) SetBaseline(ctx context.Context, key string, runID string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	baselines, err := store.readBaselines()
+	if err != nil {
+		return err
+	}
+
+	baselines[key] = runID
+
+	if err := os.MkdirAll(store.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create store directory: %w", err)
+	}
+
+	return writeJSON(filepath.Join(store.dir, baselinesFile), baselines)
+}
+
+// GetBaseline returns the ID of the baseline run for key
+func (store *Store) GetBaseline(ctx context.Context, key string) (string, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	baselines, err := store.readBaselines()
+	if err != nil {
+		return "", err
+	}
+
+	runID, ok := baselines[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return runID, nil
+}
+
+// readBaselines loads the baseline index, which may not exist yet
+func (store *Store) readBaselines() (map[string]string, error) {
+	baselines := make(map[string]string)
+
+	err := readJSON(filepath.Join(store.dir, baselinesFile), &baselines)
+	if errors.Is(err, ErrNotFound) {
+		return baselines, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return baselines, nil
+}
@@ -0,0 +1,88 @@
+package file_store
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Run is a stored load test run
+type Run struct {
+	ID          string          `json:"id"`
+	Mode        string          `json:"mode"`
+	ServiceName string          `json:"service_name"`
+	Protocol    string          `json:"protocol"`
+	Profile     string          `json:"profile"`
+	Tags        []string        `json:"tags,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Seed        *int64          `json:"seed,omitempty"` // Seed of the random choices of the run
+	StartedAt   time.Time       `json:"started_at"`
+	FinishedAt  time.Time       `json:"finished_at"`
+	Param       json.RawMessage `json:"param"`
+	Result      json.RawMessage `json:"result"`
+	Environment json.RawMessage `json:"environment,omitempty"` // Machine, build and config the run was produced with
+	Stats       RunStats        `json:"stats"`
+}
+
+// RunStats holds the raw figures needed to compare runs statistically
+type RunStats struct {
+	TotalRequests   int64     `json:"total_requests"`
+	FailedRequests  int64     `json:"failed_requests"`
+	AverageRPS      float64   `json:"average_rps"`
+	LatencySampleMs []float64 `json:"latency_sample_ms"`
+}
+
+// RunSummary is the indexed form of a run, enough to list and filter runs
+// without reading their files
+type RunSummary struct {
+	ID          string          `json:"id"`
+	Mode        string          `json:"mode"`
+	ServiceName string          `json:"service_name"`
+	Protocol    string          `json:"protocol"`
+	Profile     string          `json:"profile"`
+	Tags        []string        `json:"tags,omitempty"`
+	Description string          `json:"description,omitempty"`
+	StartedAt   time.Time       `json:"started_at"`
+	AverageRPS  float64         `json:"average_rps"`
+	Environment json.RawMessage `json:"environment,omitempty"`
+}
+
+// RunFilter narrows down ListRuns, empty fields match everything
+type RunFilter struct {
+	Mode        string
+	ServiceName string
+	Protocol    string
+	Profile     string
+	Tags        []string // Runs must have every tag
+	Query       string   // Case-insensitive text searched in the description, tags and environment
+}
+
+// Key identifies the target/protocol/profile a run belongs to
+func (run *Run) Key() string {
+	return BaselineKey(run.ServiceName, run.Protocol, run.Profile)
+}
+
+// Summary returns the indexed form of the run
+func (run *Run) Summary() *RunSummary {
+	return &RunSummary{
+		ID:          run.ID,
+		Mode:        run.Mode,
+		ServiceName: run.ServiceName,
+		Protocol:    run.Protocol,
+		Profile:     run.Profile,
+		Tags:        run.Tags,
+		Description: run.Description,
+		StartedAt:   run.StartedAt,
+		AverageRPS:  run.Stats.AverageRPS,
+		Environment: run.Environment,
+	}
+}
+
+// Key identifies the target/protocol/profile a run belongs to
+func (summary *RunSummary) Key() string {
+	return BaselineKey(summary.ServiceName, summary.Protocol, summary.Profile)
+}
+
+// BaselineKey builds the key baselines are stored under
+func BaselineKey(serviceName, protocol, profile string) string {
+	return serviceName + "/" + protocol + "/" + profile
+}
@@ -0,0 +1,187 @@
+package file_store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	runsDir      = "runs"
+	runIndexFile = "index.json"
+)
+
+// SaveRun writes a run to its own file, replacing an existing run with the
+// same ID, and records its summary in the run index
+func (store *Store) SaveRun(ctx context.Context, run *Run) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	dir := filepath.Join(store.dir, runsDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create runs directory: %w", err)
+	}
+
+	index, err := store.readRunIndex()
+	if err != nil {
+		return err
+	}
+
+	if err := writeJSON(filepath.Join(dir, run.ID+".json"), run); err != nil {
+		return err
+	}
+
+	index[run.ID] = run.Summary()
+
+	return writeJSON(filepath.Join(dir, runIndexFile), index)
+}
+
+// GetRun reads a single run
+func (store *Store) GetRun(ctx context.Context, id string) (*Run, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	// Reject IDs that would escape the runs directory
+	if id == "" || strings.ContainsAny(id, `/\`) || strings.Contains(id, "..") {
+		return nil, ErrNotFound
+	}
+
+	run := &Run{}
+	if err := readJSON(filepath.Join(store.dir, runsDir, id+".json"), run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// ListRuns returns the summaries of the runs matching filter, newest first
+func (store *Store) ListRuns(ctx context.Context, filter *RunFilter) ([]*RunSummary, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	index, err := store.readRunIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*RunSummary, 0, len(index))
+	for _, summary := range index {
+		if filter != nil && !filter.matches(summary) {
+			continue
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].StartedAt.After(summaries[j].StartedAt)
+	})
+
+	return summaries, nil
+}
+
+// readRunIndex loads the run index. Run directories written before the index
+// existed have none, it is then rebuilt from the run files and persisted by
+// the next SaveRun.
+func (store *Store) readRunIndex() (map[string]*RunSummary, error) {
+	index := make(map[string]*RunSummary)
+
+	err := readJSON(filepath.Join(store.dir, runsDir, runIndexFile), &index)
+	if err == nil {
+		return index, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(store.dir, runsDir))
+	if errors.Is(err, fs.ErrNotExist) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read runs directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" || entry.Name() == runIndexFile {
+			continue
+		}
+
+		run := &Run{}
+		if err := readJSON(filepath.Join(store.dir, runsDir, entry.Name()), run); err != nil {
+			return nil, err
+		}
+
+		index[run.ID] = run.Summary()
+	}
+
+	return index, nil
+}
+
+func (filter *RunFilter) matches(run *RunSummary) bool {
+	if (filter.Mode != "" && filter.Mode != run.Mode) ||
+		(filter.ServiceName != "" && filter.ServiceName != run.ServiceName) ||
+		(filter.Protocol != "" && filter.Protocol != run.Protocol) ||
+		(filter.Profile != "" && filter.Profile != run.Profile) {
+		return false
+	}
+
+	for _, tag := range filter.Tags {
+		if !slices.Contains(run.Tags, tag) {
+			return false
+		}
+	}
+
+	if filter.Query != "" {
+		query := strings.ToLower(filter.Query)
+		text := strings.ToLower(run.Description + "\n" + strings.Join(run.Tags, "\n") + "\n" + string(run.Environment))
+		if !strings.Contains(text, query) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeJSON atomically replaces path with the JSON encoding of v
+func writeJSON(path string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", filepath.Base(path), err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
+
+// readJSON decodes the JSON file at path into v
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package file_store
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrNotFound is returned when a run or baseline does not exist
+var ErrNotFound = errors.New("not found")
+
+type IStore interface {
+	SaveRun(ctx context.Context, run *Run) error
+	GetRun(ctx context.Context, id string) (*Run, error)
+	ListRuns(ctx context.Context, filter *RunFilter) ([]*RunSummary, error)
+
+	SetBaseline(ctx context.Context, key string, runID string) error
+	GetBaseline(ctx context.Context, key string) (string, error)
+}
+
+// Store keeps runs as JSON files in a directory
+type Store struct {
+	logger *logrus.Logger
+	mutex  sync.RWMutex
+
+	dir string
+}
+
+func NewStore(logger *logrus.Logger, dir string) IStore {
+	return &Store{
+		logger: logger,
+
+		dir: dir,
+	}
+}
@@ -17,7 +17,21 @@ func bindEnvironmentVariables() {
 	viper.BindEnv("external_service.go_gateway.name", "GO_GATEWAY_NAME")
 	viper.BindEnv("external_service.go_gateway.host", "GO_GATEWAY_HOST")
 	viper.BindEnv("external_service.go_gateway.port", "GO_GATEWAY_PORT")
+	viper.BindEnv("external_service.go_gateway.info_url", "GO_GATEWAY_INFO_URL")
 	viper.BindEnv("external_service.py_gateway.name", "PY_GATEWAY_NAME")
 	viper.BindEnv("external_service.py_gateway.host", "PY_GATEWAY_HOST")
 	viper.BindEnv("external_service.py_gateway.port", "PY_GATEWAY_PORT")
+	viper.BindEnv("external_service.py_gateway.info_url", "PY_GATEWAY_INFO_URL")
+
+	// Store config
+
+	viper.BindEnv("store.file.dir", "STORE_FILE_DIR")
+
+	// Replay config
+
+	viper.BindEnv("replay.dir", "REPLAY_DIR")
+
+	// Resource monitor config
+
+	viper.BindEnv("resource_monitor.docker_socket", "RESOURCE_MONITOR_DOCKER_SOCKET")
 }
@@ -2,39 +2,72 @@ package config
 
 // Config holds all configuration for the application
 type Config struct {
-	App             App             `mapstructure:"app"`
-	ExternalService ExternalService `mapstructure:"external_service"`
-	OtelTracer      OtelTracer      `mapstructure:"otel_tracer"`
+	App             App             `mapstructure:"app" json:"app"`
+	ExternalService ExternalService `mapstructure:"external_service" json:"external_service"`
+	Store           Store           `mapstructure:"store" json:"store"`
+	Replay          Replay          `mapstructure:"replay" json:"replay"`
+	ResourceMonitor ResourceMonitor `mapstructure:"resource_monitor" json:"resource_monitor"`
+	OtelTracer      OtelTracer      `mapstructure:"otel_tracer" json:"otel_tracer"`
 }
 
 // App config
 
 type Port struct {
-	Rest int `mapstructure:"rest"`
+	Rest int `mapstructure:"rest" json:"rest"`
 }
 
 type App struct {
-	Name string `mapstructure:"name"`
-	Host string `mapstructure:"host"`
-	Port Port   `mapstructure:"port"`
+	Name string `mapstructure:"name" json:"name"`
+	Host string `mapstructure:"host" json:"host"`
+	Port Port   `mapstructure:"port" json:"port"`
 }
 
 // External service config
 
 type Service struct {
-	Name string `mapstructure:"name"`
-	Host string `mapstructure:"host"`
-	Port int    `mapstructure:"port"`
+	Name    string `mapstructure:"name" json:"name"`
+	Host    string `mapstructure:"host" json:"host"`
+	Port    int    `mapstructure:"port" json:"port"`
+	InfoURL string `mapstructure:"info_url" json:"info_url"` // Optional HTTP endpoint exposing version info, stored with each run
 }
 
 type ExternalService struct {
-	GoGateway Service `mapstructure:"go_gateway"`
-	PyGateway Service `mapstructure:"py_gateway"`
+	GoGateway Service `mapstructure:"go_gateway" json:"go_gateway"`
+	PyGateway Service `mapstructure:"py_gateway" json:"py_gateway"`
+}
+
+// Store config
+
+type FileStore struct {
+	Dir string `mapstructure:"dir" json:"dir"`
+}
+
+type Store struct {
+	File FileStore `mapstructure:"file" json:"file"`
+}
+
+// Replay config
+
+type Replay struct {
+	Dir string `mapstructure:"dir" json:"dir"` // Directory replay files are read from, empty disables replay files
+}
+
+// Resource monitor config
+
+type Stack struct {
+	Name     string   `mapstructure:"name" json:"name"`
+	Protocol string   `mapstructure:"protocol" json:"protocol"` // Tests over this protocol are served by the stack
+	Services []string `mapstructure:"services" json:"services"` // Container names
+}
+
+type ResourceMonitor struct {
+	DockerSocket string  `mapstructure:"docker_socket" json:"docker_socket"` // Empty disables per-service sampling
+	Stacks       []Stack `mapstructure:"stacks" json:"stacks"`
 }
 
 // Otel tracer config
 
 type OtelTracer struct {
-	Name     string `mapstructure:"name"`
-	Endpoint string `mapstructure:"endpoint"`
+	Name     string `mapstructure:"name" json:"name"`
+	Endpoint string `mapstructure:"endpoint" json:"endpoint"`
 }
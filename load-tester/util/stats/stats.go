@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// MannWhitneyU runs a two-sided Mann-Whitney U test on two independent
+// samples, using the normal approximation with tie correction.
+//
+// It returns the U statistic of a, the z score and the p-value. A positive z
+// means values in b tend to be larger than values in a.
+func MannWhitneyU(a, b []float64) (u, z, p float64) {
+	n1, n2 := float64(len(a)), float64(len(b))
+	if n1 == 0 || n2 == 0 {
+		return 0, 0, 1
+	}
+
+	type value struct {
+		v     float64
+		first bool
+	}
+
+	values := make([]value, 0, len(a)+len(b))
+	for _, v := range a {
+		values = append(values, value{v: v, first: true})
+	}
+	for _, v := range b {
+		values = append(values, value{v: v})
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].v < values[j].v })
+
+	// Assign average ranks to ties and accumulate the tie correction term
+	var rankSumA, tieTerm float64
+	for i := 0; i < len(values); {
+		j := i
+		for j < len(values) && values[j].v == values[i].v {
+			j++
+		}
+
+		rank := float64(i+j+1) / 2 // ranks are 1-based
+		for k := i; k < j; k++ {
+			if values[k].first {
+				rankSumA += rank
+			}
+		}
+
+		t := float64(j - i)
+		tieTerm += t*t*t - t
+		i = j
+	}
+
+	u = rankSumA - n1*(n1+1)/2
+	mean := n1 * n2 / 2
+	n := n1 + n2
+	variance := n1 * n2 / 12 * ((n + 1) - tieTerm/(n*(n-1)))
+	if variance <= 0 {
+		return u, 0, 1
+	}
+
+	// With continuity correction
+	diff := mean - u
+	switch {
+	case diff > 0:
+		diff -= 0.5
+	case diff < 0:
+		diff += 0.5
+	}
+
+	z = diff / math.Sqrt(variance)
+	p = 2 * (1 - NormalCDF(math.Abs(z)))
+
+	return u, z, p
+}
+
+// TwoProportionZ tests whether two proportions differ, returning the z score
+// and the two-sided p-value. A positive z means the second proportion is larger.
+func TwoProportionZ(successA, totalA, successB, totalB int64) (z, p float64) {
+	if totalA == 0 || totalB == 0 {
+		return 0, 1
+	}
+
+	pA := float64(successA) / float64(totalA)
+	pB := float64(successB) / float64(totalB)
+	pooled := float64(successA+successB) / float64(totalA+totalB)
+
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(totalA) + 1/float64(totalB)))
+	if se == 0 {
+		return 0, 1
+	}
+
+	z = (pB - pA) / se
+	p = 2 * (1 - NormalCDF(math.Abs(z)))
+
+	return z, p
+}
+
+// Sample returns up to limit values drawn at random without replacement, in
+// random order. Unlike values taken at fixed ranks, the draws are independent
+// observations the tests above can be run on. values is left untouched.
+func Sample(values []float64, limit int, seed uint64) []float64 {
+	sample := append([]float64(nil), values...)
+	if len(sample) <= limit {
+		return sample
+	}
+
+	// Partial Fisher-Yates shuffle of the first limit values
+	rng := rand.New(rand.NewPCG(seed, seed))
+	for i := 0; i < limit; i++ {
+		j := i + rng.IntN(len(sample)-i)
+		sample[i], sample[j] = sample[j], sample[i]
+	}
+
+	return sample[:limit]
+}
+
+// BootstrapPercentileDiff resamples both samples and returns the share of
+// resamples in which the given percentile of b exceeds that of a by more than
+// margin (a relative amount, e.g. 0.05 for 5%), and the share in which it is
+// below a by more than margin.
+func BootstrapPercentileDiff(a, b []float64, percentile, margin float64, resamples int, seed uint64) (above, below float64) {
+	if len(a) == 0 || len(b) == 0 || resamples <= 0 {
+		return 0, 0
+	}
+
+	rng := rand.New(rand.NewPCG(seed, seed))
+	bufA := make([]float64, len(a))
+	bufB := make([]float64, len(b))
+
+	var countAbove, countBelow int
+	for i := 0; i < resamples; i++ {
+		for j := range bufA {
+			bufA[j] = a[rng.IntN(len(a))]
+		}
+		for j := range bufB {
+			bufB[j] = b[rng.IntN(len(b))]
+		}
+
+		pa := Percentile(bufA, percentile)
+		pb := Percentile(bufB, percentile)
+
+		switch {
+		case pb > pa*(1+margin):
+			countAbove++
+		case pb < pa*(1-margin):
+			countBelow++
+		}
+	}
+
+	return float64(countAbove) / float64(resamples), float64(countBelow) / float64(resamples)
+}
+
+// Percentile returns the nearest-rank percentile of values. The slice is
+// sorted in place.
+func Percentile(values []float64, percentile float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sort.Float64s(values)
+
+	index := int(math.Ceil(percentile/100*float64(len(values)))) - 1
+	if index < 0 {
+		index = 0
+	}
+
+	return values[index]
+}
+
+// Median returns the median of values without modifying the slice
+func Median(values []float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+
+	return Percentile(sorted, 50)
+}
+
+// NormalCDF is the cumulative distribution function of the standard normal distribution
+func NormalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
@@ -0,0 +1,211 @@
+package stats
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+const epsilon = 1e-4
+
+func TestMannWhitneyU(t *testing.T) {
+	// Reference values from the normal approximation with tie and continuity
+	// corrections, as computed by scipy.stats.mannwhitneyu(method="asymptotic")
+	tests := map[string]struct {
+		a, b    []float64
+		u, z, p float64
+	}{
+		"separated": {
+			a: []float64{1, 2, 3, 4, 5},
+			b: []float64{6, 7, 8, 9, 10},
+			u: 0, z: 2.506718, p: 0.012186,
+		},
+		"ties": {
+			a: []float64{1, 2, 2, 3, 3},
+			b: []float64{2, 3, 3, 4, 5},
+			u: 5, z: 1.528537, p: 0.126379,
+		},
+		"identical": {
+			a: []float64{1, 2, 3},
+			b: []float64{1, 2, 3},
+			u: 4.5, z: 0, p: 1,
+		},
+		"all tied": {
+			a: []float64{7, 7},
+			b: []float64{7, 7, 7},
+			u: 3, z: 0, p: 1,
+		},
+		"empty": {
+			a: nil,
+			b: []float64{1, 2},
+			u: 0, z: 0, p: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			u, z, p := MannWhitneyU(tt.a, tt.b)
+			if math.Abs(u-tt.u) > epsilon || math.Abs(z-tt.z) > epsilon || math.Abs(p-tt.p) > epsilon {
+				t.Fatalf("MannWhitneyU = (%v, %v, %v), want (%v, %v, %v)", u, z, p, tt.u, tt.z, tt.p)
+			}
+		})
+	}
+}
+
+func TestMannWhitneyUSymmetric(t *testing.T) {
+	a := []float64{1, 2, 2, 3, 3}
+	b := []float64{2, 3, 3, 4, 5}
+
+	_, zab, pab := MannWhitneyU(a, b)
+	_, zba, pba := MannWhitneyU(b, a)
+	if math.Abs(zab+zba) > epsilon || math.Abs(pab-pba) > epsilon {
+		t.Fatalf("swapped samples give z %v/%v and p %v/%v, want opposite z and equal p", zab, zba, pab, pba)
+	}
+}
+
+func TestTwoProportionZ(t *testing.T) {
+	tests := map[string]struct {
+		successA, totalA, successB, totalB int64
+		z, p                               float64
+	}{
+		"different": {successA: 10, totalA: 100, successB: 20, totalB: 100, z: 1.980295, p: 0.047670},
+		"equal":     {successA: 10, totalA: 100, successB: 10, totalB: 100, z: 0, p: 1},
+		"no total":  {successA: 0, totalA: 0, successB: 5, totalB: 10, z: 0, p: 1},
+		"no counts": {successA: 0, totalA: 100, successB: 0, totalB: 100, z: 0, p: 1},
+		"all count": {successA: 100, totalA: 100, successB: 50, totalB: 50, z: 0, p: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			z, p := TwoProportionZ(tt.successA, tt.totalA, tt.successB, tt.totalB)
+			if math.Abs(z-tt.z) > epsilon || math.Abs(p-tt.p) > epsilon {
+				t.Fatalf("TwoProportionZ = (%v, %v), want (%v, %v)", z, p, tt.z, tt.p)
+			}
+		})
+	}
+}
+
+func TestBootstrapPercentileDiff(t *testing.T) {
+	constant := func(value float64, n int) []float64 {
+		values := make([]float64, n)
+		for i := range values {
+			values[i] = value
+		}
+		return values
+	}
+
+	tests := map[string]struct {
+		a, b         []float64
+		above, below float64
+	}{
+		"higher":    {a: constant(10, 50), b: constant(20, 50), above: 1},
+		"lower":     {a: constant(20, 50), b: constant(10, 50), below: 1},
+		"equal":     {a: constant(10, 50), b: constant(10, 50)},
+		"in margin": {a: constant(100, 50), b: constant(104, 50)},
+		"empty":     {a: nil, b: constant(10, 50)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			above, below := BootstrapPercentileDiff(tt.a, tt.b, 95, 0.05, 200, 1)
+			if above != tt.above || below != tt.below {
+				t.Fatalf("BootstrapPercentileDiff = (%v, %v), want (%v, %v)", above, below, tt.above, tt.below)
+			}
+		})
+	}
+}
+
+func TestBootstrapPercentileDiffSeed(t *testing.T) {
+	a := []float64{1, 5, 2, 8, 3, 9, 4, 7, 6, 10}
+	b := []float64{2, 6, 3, 9, 4, 10, 5, 8, 7, 11}
+
+	above1, below1 := BootstrapPercentileDiff(a, b, 50, 0.05, 500, 7)
+	above2, below2 := BootstrapPercentileDiff(a, b, 50, 0.05, 500, 7)
+	if above1 != above2 || below1 != below2 {
+		t.Fatalf("same seed gives (%v, %v) and (%v, %v)", above1, below1, above2, below2)
+	}
+	if above1 <= below1 {
+		t.Fatalf("above = %v, below = %v, want mostly above for a shifted sample", above1, below1)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}
+
+	tests := map[string]struct {
+		values     []float64
+		percentile float64
+		want       float64
+	}{
+		"p0":     {values: values, percentile: 0, want: 1},
+		"p50":    {values: values, percentile: 50, want: 5},
+		"p95":    {values: values, percentile: 95, want: 10},
+		"p100":   {values: values, percentile: 100, want: 10},
+		"single": {values: []float64{3}, percentile: 99, want: 3},
+		"empty":  {percentile: 50, want: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Percentile(append([]float64(nil), tt.values...), tt.percentile)
+			if got != tt.want {
+				t.Fatalf("Percentile = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalCDF(t *testing.T) {
+	tests := map[string]struct {
+		x, want float64
+	}{
+		"zero":     {x: 0, want: 0.5},
+		"positive": {x: 1.96, want: 0.975002},
+		"negative": {x: -1.96, want: 0.024998},
+		"far":      {x: 10, want: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := NormalCDF(tt.x); math.Abs(got-tt.want) > epsilon {
+				t.Fatalf("NormalCDF(%v) = %v, want %v", tt.x, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSample(t *testing.T) {
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i] = float64(i)
+	}
+
+	sample := Sample(values, 100, 1)
+	if len(sample) != 100 {
+		t.Fatalf("len(sample) = %d, want 100", len(sample))
+	}
+
+	seen := make(map[float64]bool)
+	for _, value := range sample {
+		if seen[value] {
+			t.Fatalf("value %v drawn twice", value)
+		}
+		seen[value] = true
+	}
+
+	if slices.IsSorted(sample) {
+		t.Fatalf("sample is sorted, want random order")
+	}
+	if !slices.Equal(sample, Sample(values, 100, 1)) {
+		t.Fatalf("same seed gives different samples")
+	}
+	for i, value := range values {
+		if value != float64(i) {
+			t.Fatalf("values modified at %d", i)
+		}
+	}
+
+	if short := Sample(values[:10], 100, 1); !slices.Equal(short, values[:10]) {
+		t.Fatalf("Sample of fewer values than limit = %v, want all values", short)
+	}
+}